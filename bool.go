@@ -0,0 +1,39 @@
+package opt
+
+// And3 returns a AND b in three-valued (Kleene) logic, where null represents unknown, as in SQL.
+// False AND unknown is false, true AND unknown is unknown.
+func And3(a, b Option[bool]) Option[bool] {
+	if (a.Valid && !a.V) || (b.Valid && !b.V) {
+		return From(false)
+	}
+
+	if !a.Valid || !b.Valid {
+		return New[bool]()
+	}
+
+	return From(true)
+}
+
+// Or3 returns a OR b in three-valued (Kleene) logic, where null represents unknown, as in SQL.
+// True OR unknown is true, false OR unknown is unknown.
+func Or3(a, b Option[bool]) Option[bool] {
+	if (a.Valid && a.V) || (b.Valid && b.V) {
+		return From(true)
+	}
+
+	if !a.Valid || !b.Valid {
+		return New[bool]()
+	}
+
+	return From(false)
+}
+
+// Not3 returns NOT a in three-valued (Kleene) logic, where null represents unknown, as in SQL.
+// NOT unknown is unknown.
+func Not3(a Option[bool]) Option[bool] {
+	if !a.Valid {
+		return New[bool]()
+	}
+
+	return From(!a.V)
+}
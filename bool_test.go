@@ -0,0 +1,55 @@
+package opt_test
+
+import (
+	"testing"
+
+	"github.com/FallenTaters/opt"
+)
+
+var (
+	unknown = opt.New[bool]()
+	yes     = opt.From(true)
+	no      = opt.From(false)
+)
+
+func TestAnd3(t *testing.T) {
+	cases := []struct{ a, b, result opt.Option[bool] }{
+		{yes, yes, yes},
+		{yes, no, no},
+		{yes, unknown, unknown},
+		{no, yes, no},
+		{no, no, no},
+		{no, unknown, no},
+		{unknown, yes, unknown},
+		{unknown, no, no},
+		{unknown, unknown, unknown},
+	}
+
+	for _, c := range cases {
+		assertEq(t, opt.And3(c.a, c.b), c.result)
+	}
+}
+
+func TestOr3(t *testing.T) {
+	cases := []struct{ a, b, result opt.Option[bool] }{
+		{yes, yes, yes},
+		{yes, no, yes},
+		{yes, unknown, yes},
+		{no, yes, yes},
+		{no, no, no},
+		{no, unknown, unknown},
+		{unknown, yes, yes},
+		{unknown, no, unknown},
+		{unknown, unknown, unknown},
+	}
+
+	for _, c := range cases {
+		assertEq(t, opt.Or3(c.a, c.b), c.result)
+	}
+}
+
+func TestNot3(t *testing.T) {
+	assertEq(t, opt.Not3(yes), no)
+	assertEq(t, opt.Not3(no), yes)
+	assertEq(t, opt.Not3(unknown), unknown)
+}
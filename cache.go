@@ -0,0 +1,35 @@
+package opt
+
+import "sync"
+
+// OptionCache is a concurrency-safe cache whose lookups return an Option.
+// The zero value is an empty cache ready to use.
+type OptionCache[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+}
+
+// Get returns the value stored for k, or null if there is none
+func (c *OptionCache[K, V]) Get(k K) Option[V] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	v, ok := c.m[k]
+	if !ok {
+		return New[V]()
+	}
+
+	return From(v)
+}
+
+// Put stores v for k
+func (c *OptionCache[K, V]) Put(k K, v V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.m == nil {
+		c.m = make(map[K]V)
+	}
+
+	c.m[k] = v
+}
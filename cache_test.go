@@ -0,0 +1,23 @@
+package opt_test
+
+import (
+	"testing"
+
+	"github.com/FallenTaters/opt"
+)
+
+func TestOptionCache(t *testing.T) {
+	var c opt.OptionCache[string, int]
+
+	assertEq(t, c.Get("a"), opt.New[int]())
+
+	c.Put("a", 1)
+	c.Put("zero", 0)
+
+	assertEq(t, c.Get("a"), opt.From(1))
+	assertEq(t, c.Get("zero"), opt.From(0))
+	assertEq(t, c.Get("b"), opt.New[int]())
+
+	c.Put("a", 2)
+	assertEq(t, c.Get("a"), opt.From(2))
+}
@@ -0,0 +1,67 @@
+package opt
+
+import (
+	"database/sql"
+	"encoding/json"
+	"flag"
+)
+
+var (
+	_ json.Unmarshaler = &Dirty[struct{}]{}
+	_ sql.Scanner      = &Dirty[struct{}]{}
+	_ flag.Value       = &Dirty[struct{}]{}
+)
+
+// Dirty is an Option[T] that records whether it was modified by SetValue, SetNull, Reset, Set, UnmarshalJSON or Scan,
+// regardless of whether the new value is null. This is useful for updating only the changed fields.
+// A failed call does not count as a modification, and neither does assigning V or Valid directly.
+type Dirty[T any] struct {
+	Option[T]
+
+	modified bool
+}
+
+// Modified reports whether Dirty was successfully modified by one of its methods
+func (d Dirty[T]) Modified() bool {
+	return d.modified
+}
+
+// SetValue sets Dirty to a non-null v
+func (d *Dirty[T]) SetValue(v T) {
+	d.Option = From(v)
+	d.modified = true
+}
+
+// SetNull sets Dirty to null
+func (d *Dirty[T]) SetNull() {
+	d.Option = New[T]()
+	d.modified = true
+}
+
+// Reset sets Dirty to null in place, like SetNull
+func (d *Dirty[T]) Reset() {
+	d.SetNull()
+}
+
+// Set implements flag.Value
+func (d *Dirty[T]) Set(s string) error {
+	return d.touch(d.Option.Set(s))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Dirty[T]) UnmarshalJSON(data []byte) error {
+	return d.touch(d.Option.UnmarshalJSON(data))
+}
+
+// Scan implements sql.Scanner
+func (d *Dirty[T]) Scan(src any) error {
+	return d.touch(d.Option.Scan(src))
+}
+
+func (d *Dirty[T]) touch(err error) error {
+	if err == nil {
+		d.modified = true
+	}
+
+	return err
+}
@@ -0,0 +1,69 @@
+package opt_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/FallenTaters/opt"
+)
+
+func TestDirty(t *testing.T) {
+	t.Run("fresh", func(t *testing.T) {
+		var d opt.Dirty[int]
+		assertEq(t, d.Modified(), false)
+
+		d = opt.Dirty[int]{Option: opt.From(1)}
+		assertEq(t, d.Modified(), false)
+	})
+
+	t.Run("SetValue", func(t *testing.T) {
+		var d opt.Dirty[int]
+		d.SetValue(0)
+		assertEq(t, d.Modified(), true)
+		assertEq(t, d.Option, opt.From(0))
+	})
+
+	t.Run("SetNull", func(t *testing.T) {
+		d := opt.Dirty[int]{Option: opt.From(1)}
+		d.SetNull()
+		assertEq(t, d.Modified(), true)
+		assertEq(t, d.Option, opt.New[int]())
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		d := opt.Dirty[int]{Option: opt.From(1)}
+		d.Reset()
+		assertEq(t, d.Modified(), true)
+		assertEq(t, d.Option, opt.New[int]())
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		var d opt.Dirty[int]
+		assertEq(t, d.Set("x") != nil, true)
+		assertEq(t, d.Modified(), false)
+
+		assertErrorEq(t, d.Set("3"), nil)
+		assertEq(t, d.Modified(), true)
+		assertEq(t, d.Option, opt.From(3))
+	})
+
+	t.Run("UnmarshalJSON", func(t *testing.T) {
+		var s struct {
+			A opt.Dirty[int]
+			B opt.Dirty[int]
+		}
+		if err := json.Unmarshal([]byte(`{"A":null}`), &s); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, s.A.Modified(), true)
+		assertEq(t, s.A.Option, opt.New[int]())
+		assertEq(t, s.B.Modified(), false)
+	})
+
+	t.Run("Scan", func(t *testing.T) {
+		var d opt.Dirty[string]
+		assertErrorEq(t, d.Scan(nil), nil)
+		assertEq(t, d.Modified(), true)
+		assertEq(t, d.Option, opt.New[string]())
+	})
+}
@@ -0,0 +1,57 @@
+package opt
+
+import "os"
+
+// FromEnv creates an Option[T] from the environment variable named by key.
+// The result is null if the variable is unset or empty.
+// Otherwise the value is converted in the same way as Parse does.
+func FromEnv[T any](key string) (Option[T], error) {
+	return Parse[T](os.Getenv(key))
+}
+
+// FromEnvKeepEmpty is like FromEnv, but a variable that is set to an empty string
+// is converted like any other value instead of resulting in null.
+func FromEnvKeepEmpty[T any](key string) (Option[T], error) {
+	s, ok := os.LookupEnv(key)
+	if !ok {
+		return New[T](), nil
+	}
+
+	var o Option[T]
+	if err := o.Set(s); err != nil {
+		return New[T](), err
+	}
+
+	return o, nil
+}
+
+// FromEnvFunc is like FromEnv, but converts the value of the variable using parse.
+// The result is null if the variable is unset or empty, and parse is not called.
+func FromEnvFunc[T any](key string, parse func(string) (T, error)) (Option[T], error) {
+	s := os.Getenv(key)
+	if s == "" {
+		return New[T](), nil
+	}
+
+	return fromEnvParse(s, parse)
+}
+
+// FromEnvFuncKeepEmpty is like FromEnvFunc, but a variable that is set to an empty string
+// is passed to parse like any other value instead of resulting in null.
+func FromEnvFuncKeepEmpty[T any](key string, parse func(string) (T, error)) (Option[T], error) {
+	s, ok := os.LookupEnv(key)
+	if !ok {
+		return New[T](), nil
+	}
+
+	return fromEnvParse(s, parse)
+}
+
+func fromEnvParse[T any](s string, parse func(string) (T, error)) (Option[T], error) {
+	v, err := parse(s)
+	if err != nil {
+		return New[T](), err
+	}
+
+	return From(v), nil
+}
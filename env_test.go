@@ -0,0 +1,93 @@
+package opt_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/FallenTaters/opt"
+)
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("OPT_TEST_SET", "123")
+	t.Setenv("OPT_TEST_EMPTY", "")
+	t.Setenv("OPT_TEST_INVALID", "abc")
+	t.Setenv("OPT_TEST_HEX", "ff")
+	t.Setenv("OPT_TEST_NOT_HEX", "xyz")
+
+	t.Run("FromEnv", func(t *testing.T) {
+		o, err := opt.FromEnv[int]("OPT_TEST_SET")
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.From(123))
+
+		o, err = opt.FromEnv[int]("OPT_TEST_UNSET")
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.New[int]())
+
+		o, err = opt.FromEnv[int]("OPT_TEST_EMPTY")
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.New[int]())
+
+		o, err = opt.FromEnv[int]("OPT_TEST_INVALID")
+		assertEq(t, err != nil, true)
+		assertEq(t, o, opt.New[int]())
+	})
+
+	t.Run("FromEnvKeepEmpty", func(t *testing.T) {
+		o, err := opt.FromEnvKeepEmpty[string]("OPT_TEST_SET")
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.From("123"))
+
+		o, err = opt.FromEnvKeepEmpty[string]("OPT_TEST_UNSET")
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.New[string]())
+
+		o, err = opt.FromEnvKeepEmpty[string]("OPT_TEST_EMPTY")
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.From(""))
+
+		i, err := opt.FromEnvKeepEmpty[int]("OPT_TEST_EMPTY")
+		assertEq(t, err != nil, true)
+		assertEq(t, i, opt.New[int]())
+	})
+
+	t.Run("FromEnvFunc", func(t *testing.T) {
+		parseHex := func(s string) (int64, error) { return strconv.ParseInt(s, 16, 64) }
+
+		o, err := opt.FromEnvFunc("OPT_TEST_HEX", parseHex)
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.From(int64(255)))
+
+		o, err = opt.FromEnvFunc("OPT_TEST_UNSET", parseHex)
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.New[int64]())
+
+		o, err = opt.FromEnvFunc("OPT_TEST_EMPTY", parseHex)
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.New[int64]())
+
+		o, err = opt.FromEnvFunc("OPT_TEST_NOT_HEX", parseHex)
+		assertEq(t, err != nil, true)
+		assertEq(t, o, opt.New[int64]())
+	})
+
+	t.Run("FromEnvFuncKeepEmpty", func(t *testing.T) {
+		calls := 0
+		parseLen := func(s string) (int, error) {
+			calls++
+			return len(s), nil
+		}
+
+		o, err := opt.FromEnvFuncKeepEmpty("OPT_TEST_HEX", parseLen)
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.From(2))
+
+		o, err = opt.FromEnvFuncKeepEmpty("OPT_TEST_EMPTY", parseLen)
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.From(0))
+
+		o, err = opt.FromEnvFuncKeepEmpty("OPT_TEST_UNSET", parseLen)
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.New[int]())
+		assertEq(t, calls, 2)
+	})
+}
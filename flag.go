@@ -0,0 +1,32 @@
+package opt
+
+import "flag"
+
+var _ flag.Value = &Option[struct{}]{}
+
+// Set implements flag.Value.
+// s is converted in the same way as Scan does for a string.
+func (o *Option[T]) Set(s string) error {
+	var v Option[T]
+	if err := v.Scan(s); err != nil {
+		return err
+	}
+
+	*o = v
+	return nil
+}
+
+// IsBoolFlag reports whether T is bool, so that the flag package accepts -name without a value,
+// such as -verbose, as -name=true for an Option[bool].
+func (o Option[T]) IsBoolFlag() bool {
+	_, ok := any(o.V).(bool)
+	return ok
+}
+
+// Flag defines an Option[T] flag on flag.CommandLine with the specified name and usage string.
+// The returned Option stays null unless the flag is provided.
+func Flag[T any](name, usage string) *Option[T] {
+	o := new(Option[T])
+	flag.Var(o, name, usage)
+	return o
+}
@@ -0,0 +1,69 @@
+package opt_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/FallenTaters/opt"
+)
+
+func TestFlag(t *testing.T) {
+	t.Run("provided", func(t *testing.T) {
+		var port opt.Option[int]
+		var name opt.Option[string]
+
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.Var(&port, "port", "")
+		fs.Var(&name, "name", "")
+
+		assertErrorEq(t, fs.Parse([]string{"-port", "8080", "-name="}), nil)
+		assertEq(t, port, opt.From(8080))
+		assertEq(t, name, opt.From(""))
+		assertEq(t, port.String(), "8080")
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		var port opt.Option[int]
+
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.Var(&port, "port", "")
+
+		assertErrorEq(t, fs.Parse(nil), nil)
+		assertEq(t, port, opt.New[int]())
+		assertEq(t, port.String(), "null")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var port opt.Option[int]
+		assertEq(t, port.Set("abc") != nil, true)
+		assertEq(t, port, opt.New[int]())
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		var verbose, debug opt.Option[bool]
+		var port opt.Option[int]
+
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.Var(&verbose, "verbose", "")
+		fs.Var(&debug, "debug", "")
+		fs.Var(&port, "port", "")
+
+		assertErrorEq(t, fs.Parse([]string{"-verbose", "-debug=false"}), nil)
+		assertEq(t, verbose, opt.From(true))
+		assertEq(t, debug, opt.From(false))
+		assertEq(t, port.IsBoolFlag(), false)
+	})
+
+	t.Run("Flag", func(t *testing.T) {
+		defer func(fs *flag.FlagSet) { flag.CommandLine = fs }(flag.CommandLine)
+		flag.CommandLine = flag.NewFlagSet("test", flag.ContinueOnError)
+
+		o := opt.Flag[int]("opt-test-flag", "usage")
+		assertEq(t, *o, opt.New[int]())
+
+		f := flag.Lookup("opt-test-flag")
+		assertEq(t, f.Usage, "usage")
+		assertErrorEq(t, f.Value.Set("3"), nil)
+		assertEq(t, *o, opt.From(3))
+	})
+}
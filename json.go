@@ -0,0 +1,237 @@
+package opt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+)
+
+var (
+	_ json.Marshaler   = LenientBool{}
+	_ json.Unmarshaler = &LenientBool{}
+	_ json.Marshaler   = EmptyStringAsNull[struct{}]{}
+	_ json.Unmarshaler = &EmptyStringAsNull[struct{}]{}
+	_ json.Marshaler   = StringyOption[int]{}
+	_ json.Unmarshaler = &StringyOption[int]{}
+	_ json.Marshaler   = CustomNullOption[struct{}]{}
+	_ json.Unmarshaler = &CustomNullOption[struct{}]{}
+	_ json.Marshaler   = TokenOption[struct{}]{}
+	_ json.Unmarshaler = &TokenOption[struct{}]{}
+)
+
+// onMarshalNull holds the hook registered by OnMarshalNull, or nil
+var onMarshalNull atomic.Pointer[func(typeName string)]
+
+// OnMarshalNull registers f to be called with the TypeName whenever a null Option is marshalled to JSON,
+// such as for counting how sparse payloads are. A nil f removes the hook.
+// The hook is global and must be safe for concurrent use. Wrapper types with their own null encoding,
+// such as TokenOption, do not call it.
+func OnMarshalNull(f func(typeName string)) {
+	if f == nil {
+		onMarshalNull.Store(nil)
+		return
+	}
+
+	onMarshalNull.Store(&f)
+}
+
+// FromRawJSON creates an Option[json.RawMessage] that is null if data is empty or null,
+// or non-null with a copy of data otherwise. data is not validated or parsed.
+func FromRawJSON(data []byte) Option[json.RawMessage] {
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return New[json.RawMessage]()
+	}
+
+	return From(json.RawMessage(bytes.Clone(data)))
+}
+
+// DecodeAll decodes a stream of concatenated JSON values from r, such as newline-delimited JSON.
+// Each null value becomes a null Option[T].
+// If a value fails to decode, the options decoded so far are returned along with the error.
+func DecodeAll[T any](r io.Reader) ([]Option[T], error) {
+	var out []Option[T]
+
+	dec := json.NewDecoder(r)
+	for {
+		var o Option[T]
+		if err := dec.Decode(&o); err != nil {
+			if errors.Is(err, io.EOF) {
+				return out, nil
+			}
+
+			return out, err
+		}
+
+		out = append(out, o)
+	}
+}
+
+// LenientBool is an Option[bool] that also accepts the quoted booleans "true" and "false",
+// and the numbers 0 and 1, when unmarshalling JSON.
+// Option[bool] itself rejects both, just like *bool.
+type LenientBool struct {
+	Option[bool]
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (o *LenientBool) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "0":
+		o.Option = From(false)
+		return nil
+	case "1":
+		o.Option = From(true)
+		return nil
+	}
+
+	if len(data) == 0 || data[0] != '"' {
+		return o.Option.UnmarshalJSON(data)
+	}
+
+	o.Option = New[bool]()
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	switch s {
+	case "true":
+		o.Option = From(true)
+	case "false":
+		o.Option = From(false)
+	default:
+		return fmt.Errorf("opt: invalid quoted bool %s", data)
+	}
+
+	return nil
+}
+
+// EmptyStringAsNull is an Option[T] that treats the JSON string "" as null when unmarshalling, in addition to null.
+type EmptyStringAsNull[T any] struct {
+	Option[T]
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (o *EmptyStringAsNull[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte(`""`)) {
+		o.Option = New[T]()
+		return nil
+	}
+
+	return o.Option.UnmarshalJSON(data)
+}
+
+// Integer is a constraint for all integer types
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// StringyOption is an Option[T] that marshals its value as a JSON string, such as "123".
+// This prevents precision loss for large integers in clients like browsers.
+// When unmarshalling, both quoted and unquoted numbers are accepted.
+type StringyOption[T Integer] struct {
+	Option[T]
+}
+
+// MarshalJSON implements json.Marshaler
+func (o StringyOption[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return o.Option.MarshalJSON()
+	}
+
+	data, err := json.Marshal(o.V)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(data))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (o *StringyOption[T]) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || data[0] != '"' {
+		return o.Option.UnmarshalJSON(data)
+	}
+
+	o.Option = New[T]()
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	// json.Unmarshal would also accept other literals, such as "null"
+	if !isJSONNumber(s) {
+		return fmt.Errorf("opt: invalid quoted number %s", data)
+	}
+
+	var v T
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return err
+	}
+
+	o.Option = From(v)
+	return nil
+}
+
+// CustomNullOption is an Option[T] that treats the JSON strings in NullTokens as null when unmarshalling,
+// in addition to null. Tokens only match a top-level JSON string, such as "N/A".
+type CustomNullOption[T any] struct {
+	Option[T]
+
+	NullTokens []string
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (o *CustomNullOption[T]) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err == nil {
+			for _, token := range o.NullTokens {
+				if s == token {
+					o.Option = New[T]()
+					return nil
+				}
+			}
+		}
+	}
+
+	return o.Option.UnmarshalJSON(data)
+}
+
+// TokenOption is an Option[T] that is represented in JSON by the string NullToken when it is null, such as "N/A".
+// When unmarshalling, both NullToken and null are treated as null.
+// An empty NullToken means null is represented by JSON null, so a zero TokenOption behaves like Option[T],
+// and "" stays a present empty string.
+type TokenOption[T any] struct {
+	Option[T]
+
+	NullToken string
+}
+
+// MarshalJSON implements json.Marshaler
+func (o TokenOption[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid && o.NullToken != "" {
+		return json.Marshal(o.NullToken)
+	}
+
+	return o.Option.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (o *TokenOption[T]) UnmarshalJSON(data []byte) error {
+	if o.NullToken != "" && len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err == nil && s == o.NullToken {
+			o.Option = New[T]()
+			return nil
+		}
+	}
+
+	return o.Option.UnmarshalJSON(data)
+}
@@ -0,0 +1,371 @@
+package opt_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/FallenTaters/opt"
+)
+
+func TestFromRawJSON(t *testing.T) {
+	assertEq(t, opt.FromRawJSON(nil).IsNull(), true)
+	assertEq(t, opt.FromRawJSON([]byte{}).IsNull(), true)
+	assertEq(t, opt.FromRawJSON([]byte("null")).IsNull(), true)
+
+	data := []byte(`{"a": [1, 2]}`)
+	o := opt.FromRawJSON(data)
+	assertEq(t, o.Valid, true)
+	assertBytesEq(t, o.V, data)
+
+	out, err := json.Marshal(o)
+	assertErrorEq(t, err, nil)
+	assertBytesEq(t, out, []byte(`{"a":[1,2]}`))
+}
+
+func TestOptionRawMessage(t *testing.T) {
+	data, err := json.Marshal(opt.From(json.RawMessage(`{"a":1}`)))
+	assertErrorEq(t, err, nil)
+	assertEq(t, string(data), `{"a":1}`)
+
+	data, err = json.Marshal(opt.New[json.RawMessage]())
+	assertErrorEq(t, err, nil)
+	assertEq(t, string(data), `null`)
+
+	data, err = json.Marshal(struct {
+		Raw opt.Option[json.RawMessage] `json:"raw"`
+	}{opt.From(json.RawMessage(`[1,"b"]`))})
+	assertErrorEq(t, err, nil)
+	assertEq(t, string(data), `{"raw":[1,"b"]}`)
+
+	data, err = opt.From(json.RawMessage(`{ "a": 1 }`)).MarshalJSON()
+	assertErrorEq(t, err, nil)
+	assertEq(t, string(data), `{ "a": 1 }`)
+
+	data, err = opt.From(json.RawMessage(nil)).MarshalJSON()
+	assertErrorEq(t, err, nil)
+	assertEq(t, string(data), `null`)
+
+	var o opt.Option[json.RawMessage]
+	data = []byte(`{"raw":[1,"b"]}`)
+	assertErrorEq(t, json.Unmarshal(data[len(`{"raw":`):len(data)-1], &o), nil)
+	assertEq(t, string(o.V), `[1,"b"]`)
+}
+
+func TestOptionSlice(t *testing.T) {
+	cases := []struct {
+		data string
+		want opt.Option[[]int]
+	}{
+		{`null`, opt.New[[]int]()},
+		{`[]`, opt.From([]int{})},
+		{`[1,2]`, opt.From([]int{1, 2})},
+	}
+
+	for _, c := range cases {
+		var o opt.Option[[]int]
+		if err := json.Unmarshal([]byte(c.data), &o); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Valid, c.want.Valid)
+		assertEq(t, o.V == nil, c.want.V == nil)
+		assertEq(t, len(o.V), len(c.want.V))
+
+		data, err := json.Marshal(o)
+		if err != nil {
+			t.Error(err)
+		}
+		assertEq(t, string(data), c.data)
+	}
+
+	data, err := json.Marshal(opt.From([]int(nil)))
+	if err != nil {
+		t.Error(err)
+	}
+	assertEq(t, string(data), `[]`)
+
+	data, err = json.Marshal(opt.From([]byte(nil)))
+	if err != nil {
+		t.Error(err)
+	}
+	assertEq(t, string(data), `""`)
+}
+
+func TestOnMarshalNull(t *testing.T) {
+	var names []string
+	opt.OnMarshalNull(func(typeName string) { names = append(names, typeName) })
+	defer opt.OnMarshalNull(nil)
+
+	data, err := json.Marshal(struct {
+		A opt.Option[int]
+		B opt.Option[string]
+		C opt.Option[bool]
+	}{B: opt.From("")})
+	if err != nil {
+		t.Error(err)
+	}
+	assertEq(t, string(data), `{"A":null,"B":"","C":null}`)
+	assertEq(t, strings.Join(names, ","), "int,bool")
+
+	names = nil
+	data, err = json.Marshal([]opt.StringyOption[int64]{{}, {opt.From(int64(1))}})
+	if err != nil {
+		t.Error(err)
+	}
+	assertEq(t, string(data), `[null,"1"]`)
+	assertEq(t, strings.Join(names, ","), "int64")
+
+	opt.OnMarshalNull(nil)
+	if _, err := json.Marshal(opt.New[int]()); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, len(names), 1)
+}
+
+func TestDecodeAll(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		opts, err := opt.DecodeAll[int](strings.NewReader("1\nnull\n3 4\n"))
+		assertErrorEq(t, err, nil)
+		assertEq(t, len(opts), 4)
+		assertEq(t, opts[0], opt.From(1))
+		assertEq(t, opts[1], opt.New[int]())
+		assertEq(t, opts[2], opt.From(3))
+		assertEq(t, opts[3], opt.From(4))
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		opts, err := opt.DecodeAll[int](strings.NewReader("1\nnull\n\"abc\"\n4\n"))
+		assertEq(t, err != nil, true)
+		assertEq(t, len(opts), 2)
+		assertEq(t, opts[0], opt.From(1))
+		assertEq(t, opts[1], opt.New[int]())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		opts, err := opt.DecodeAll[int](strings.NewReader(""))
+		assertErrorEq(t, err, nil)
+		assertEq(t, len(opts), 0)
+	})
+}
+
+func TestLenientBool(t *testing.T) {
+	cases := []struct {
+		data   string
+		result opt.Option[bool]
+		err    bool
+	}{
+		{data: `null`, result: opt.New[bool]()},
+		{data: `true`, result: opt.From(true)},
+		{data: `false`, result: opt.From(false)},
+		{data: `"true"`, result: opt.From(true)},
+		{data: `"false"`, result: opt.From(false)},
+		{data: `"yes"`, err: true},
+		{data: `""`, err: true},
+		{data: `1`, result: opt.From(true)},
+		{data: `0`, result: opt.From(false)},
+		{data: `2`, err: true},
+		{data: `-1`, err: true},
+		{data: `1.0`, err: true},
+		{data: `"1"`, err: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.data, func(t *testing.T) {
+			var o opt.LenientBool
+			err := json.Unmarshal([]byte(c.data), &o)
+
+			assertEq(t, err != nil, c.err)
+			if !c.err {
+				assertEq(t, o.Option, c.result)
+			}
+		})
+	}
+
+	t.Run("strict", func(t *testing.T) {
+		for _, data := range []string{`"true"`, `1`, `0`} {
+			var o opt.Option[bool]
+			err := json.Unmarshal([]byte(data), &o)
+
+			assertEq(t, err != nil, true)
+		}
+	})
+
+	t.Run("marshal", func(t *testing.T) {
+		data, err := json.Marshal(opt.LenientBool{opt.From(true)})
+		assertErrorEq(t, err, nil)
+		assertEq(t, string(data), "true")
+
+		assertEq(t, fmt.Sprint(opt.LenientBool{}), "null")
+	})
+}
+
+func TestEmptyStringAsNull(t *testing.T) {
+	cases := []struct {
+		data   string
+		result opt.Option[int]
+	}{
+		{data: `""`, result: opt.New[int]()},
+		{data: `null`, result: opt.New[int]()},
+		{data: `0`, result: opt.From(0)},
+		{data: `123`, result: opt.From(123)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.data, func(t *testing.T) {
+			o := opt.EmptyStringAsNull[int]{opt.From(1)}
+			err := json.Unmarshal([]byte(c.data), &o)
+
+			assertErrorEq(t, err, nil)
+			assertEq(t, o.Option, c.result)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		var o opt.EmptyStringAsNull[int]
+		assertEq(t, json.Unmarshal([]byte(`"abc"`), &o) != nil, true)
+	})
+}
+
+func TestStringyOption(t *testing.T) {
+	const large = int64(9007199254740993)
+
+	t.Run("marshal", func(t *testing.T) {
+		data, err := json.Marshal(opt.StringyOption[int64]{opt.From(large)})
+		assertErrorEq(t, err, nil)
+		assertEq(t, string(data), `"9007199254740993"`)
+
+		data, err = json.Marshal(opt.StringyOption[int64]{})
+		assertErrorEq(t, err, nil)
+		assertEq(t, string(data), `null`)
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		cases := []struct {
+			data   string
+			result opt.Option[int64]
+			err    bool
+		}{
+			{data: `"9007199254740993"`, result: opt.From(large)},
+			{data: `9007199254740993`, result: opt.From(large)},
+			{data: `"-1"`, result: opt.From(int64(-1))},
+			{data: `null`, result: opt.New[int64]()},
+			{data: `"abc"`, err: true},
+			{data: `""`, err: true},
+			{data: `"1.5"`, err: true},
+			{data: `"null"`, err: true},
+			{data: `"true"`, err: true},
+			{data: `" 1"`, err: true},
+		}
+
+		for _, c := range cases {
+			t.Run(c.data, func(t *testing.T) {
+				var o opt.StringyOption[int64]
+				err := json.Unmarshal([]byte(c.data), &o)
+
+				assertEq(t, err != nil, c.err)
+				if !c.err {
+					assertEq(t, o.Option, c.result)
+				}
+			})
+		}
+
+		var o opt.StringyOption[int64]
+		assertErrorEq(t, json.Unmarshal([]byte(`"null"`), &o), errors.New(`opt: invalid quoted number "null"`))
+		assertEq(t, o.Option, opt.New[int64]())
+
+		for _, data := range []string{`"1e3"`, `"99999999999999999999"`} {
+			o := opt.StringyOption[int64]{Option: opt.From(int64(1))}
+			assertEq(t, json.Unmarshal([]byte(data), &o) != nil, true)
+			assertEq(t, o.Option, opt.New[int64]())
+		}
+	})
+}
+
+func TestCustomNullOption(t *testing.T) {
+	cases := []struct {
+		data   string
+		result opt.Option[string]
+	}{
+		{data: `"N/A"`, result: opt.New[string]()},
+		{data: `"-"`, result: opt.New[string]()},
+		{data: `null`, result: opt.New[string]()},
+		{data: `"hello"`, result: opt.From("hello")},
+		{data: `"n/a"`, result: opt.From("n/a")},
+	}
+
+	for _, c := range cases {
+		t.Run(c.data, func(t *testing.T) {
+			o := opt.CustomNullOption[string]{NullTokens: []string{"N/A", "-"}}
+			err := json.Unmarshal([]byte(c.data), &o)
+
+			assertErrorEq(t, err, nil)
+			assertEq(t, o.Option, c.result)
+		})
+	}
+
+	t.Run("numbers", func(t *testing.T) {
+		o := opt.CustomNullOption[int]{NullTokens: []string{"N/A"}}
+
+		assertErrorEq(t, json.Unmarshal([]byte(`"N/A"`), &o), nil)
+		assertEq(t, o.Option, opt.New[int]())
+
+		assertErrorEq(t, json.Unmarshal([]byte(`12`), &o), nil)
+		assertEq(t, o.Option, opt.From(12))
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		o := opt.CustomNullOption[[]string]{NullTokens: []string{"N/A"}}
+
+		assertErrorEq(t, json.Unmarshal([]byte(`["N/A"]`), &o), nil)
+		assertEq(t, o.Valid, true)
+		assertEq(t, o.V[0], "N/A")
+	})
+}
+
+func TestTokenOption(t *testing.T) {
+	cases := []struct {
+		option opt.Option[int]
+		data   string
+	}{
+		{option: opt.New[int](), data: `"N/A"`},
+		{option: opt.From(0), data: `0`},
+		{option: opt.From(12), data: `12`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.data, func(t *testing.T) {
+			data, err := json.Marshal(opt.TokenOption[int]{Option: c.option, NullToken: "N/A"})
+			assertErrorEq(t, err, nil)
+			assertEq(t, string(data), c.data)
+
+			o := opt.TokenOption[int]{Option: opt.From(1), NullToken: "N/A"}
+			assertErrorEq(t, json.Unmarshal(data, &o), nil)
+			assertEq(t, o.Option, c.option)
+		})
+	}
+
+	t.Run("null", func(t *testing.T) {
+		o := opt.TokenOption[int]{Option: opt.From(1), NullToken: "N/A"}
+		assertErrorEq(t, json.Unmarshal([]byte(`null`), &o), nil)
+		assertEq(t, o.Option, opt.New[int]())
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		data, err := json.Marshal(opt.TokenOption[string]{})
+		assertErrorEq(t, err, nil)
+		assertEq(t, string(data), `null`)
+
+		data, err = json.Marshal(opt.TokenOption[string]{Option: opt.From("")})
+		assertErrorEq(t, err, nil)
+		assertEq(t, string(data), `""`)
+
+		var o opt.TokenOption[string]
+		assertErrorEq(t, json.Unmarshal([]byte(`""`), &o), nil)
+		assertEq(t, o.Option, opt.From(""))
+
+		assertErrorEq(t, json.Unmarshal([]byte(`null`), &o), nil)
+		assertEq(t, o.Option, opt.New[string]())
+	})
+}
@@ -0,0 +1,39 @@
+package opt
+
+// CompactMap returns a map with the values of all non-null options in m.
+// Keys whose option is null are left out.
+func CompactMap[K comparable, V any](m map[K]Option[V]) map[K]V {
+	out := make(map[K]V, len(m))
+	for k, o := range m {
+		if o.Valid {
+			out[k] = o.V
+		}
+	}
+
+	return out
+}
+
+// MapIndex returns the value stored for k in the map contained by o.
+// It returns null if o is null or k is absent.
+func MapIndex[K comparable, V any](o Option[map[K]V], k K) Option[V] {
+	if !o.Valid {
+		return New[V]()
+	}
+
+	v, ok := o.V[k]
+	if !ok {
+		return New[V]()
+	}
+
+	return From(v)
+}
+
+// RangeOptions calls f for each key in m whose option is non-null, with its value.
+// Keys whose option is null are skipped. Like ranging over a map, the order is unspecified.
+func RangeOptions[K comparable, T any](m map[K]Option[T], f func(K, T)) {
+	for k, o := range m {
+		if o.Valid {
+			f(k, o.V)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package opt_test
+
+import (
+	"testing"
+
+	"github.com/FallenTaters/opt"
+)
+
+func TestCompactMap(t *testing.T) {
+	m := opt.CompactMap(map[string]opt.Option[int]{
+		"a": opt.From(1),
+		"b": opt.New[int](),
+		"c": opt.From(0),
+	})
+
+	assertEq(t, len(m), 2)
+	assertEq(t, m["a"], 1)
+	assertEq(t, m["c"], 0)
+	_, ok := m["b"]
+	assertEq(t, ok, false)
+
+	assertEq(t, len(opt.CompactMap[string, int](nil)), 0)
+}
+
+func TestMapIndex(t *testing.T) {
+	o := opt.From(map[string]int{"a": 1, "zero": 0})
+
+	assertEq(t, opt.MapIndex(o, "a"), opt.From(1))
+	assertEq(t, opt.MapIndex(o, "zero"), opt.From(0))
+	assertEq(t, opt.MapIndex(o, "b"), opt.New[int]())
+	assertEq(t, opt.MapIndex(opt.New[map[string]int](), "a"), opt.New[int]())
+}
+
+func TestRangeOptions(t *testing.T) {
+	visited := map[string]int{}
+	opt.RangeOptions(map[string]opt.Option[int]{
+		"a": opt.From(1),
+		"b": opt.New[int](),
+		"c": opt.From(0),
+	}, func(k string, v int) {
+		visited[k] = v
+	})
+
+	assertEq(t, len(visited), 2)
+	assertEq(t, visited["a"], 1)
+	assertEq(t, visited["c"], 0)
+	_, ok := visited["b"]
+	assertEq(t, ok, false)
+}
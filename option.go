@@ -2,11 +2,15 @@ package opt
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"database/sql/driver"
+	"encoding"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -17,8 +21,15 @@ var (
 	_ json.Unmarshaler = &Option[struct{}]{}
 	_ driver.Valuer    = Option[struct{}]{}
 	_ sql.Scanner      = &Option[struct{}]{}
+	_ Nullable         = Option[struct{}]{}
+	_ fmt.Formatter    = Option[struct{}]{}
 )
 
+// Nullable is implemented by values that can be null, such as Option[T] for any T
+type Nullable interface {
+	IsNull() bool
+}
+
 // Option is a generic wrapper for optional values compatible with `encoding/json` and `database/sql`
 type Option[T any] struct {
 	V     T
@@ -49,6 +60,76 @@ func FromPtr[T any](v *T) Option[T] {
 	return From(*v)
 }
 
+// FromValid creates a non-null Option[T] with v if validate(v) returns nil.
+// Otherwise it returns a null Option[T] and the error returned by validate.
+func FromValid[T any](v T, validate func(T) error) (Option[T], error) {
+	if err := validate(v); err != nil {
+		return New[T](), err
+	}
+
+	return From(v), nil
+}
+
+// FromErrFunc creates an Option[T] from the results of a call such as strconv.Atoi(s).
+// If err != nil, onErr is called with err, such as for logging, and a null Option[T] is returned.
+// Otherwise, the result is a non-null Option[T] with v.
+func FromErrFunc[T any](v T, err error, onErr func(error)) Option[T] {
+	if err != nil {
+		onErr(err)
+		return New[T]()
+	}
+
+	return From(v)
+}
+
+// Parse creates an Option[T] from s, converting it in the same way as Scan does for a string.
+// If s is empty, the result is a null Option[T].
+func Parse[T any](s string) (Option[T], error) {
+	if s == "" {
+		return New[T](), nil
+	}
+
+	var o Option[T]
+	if err := o.Scan(s); err != nil {
+		return New[T](), err
+	}
+
+	return o, nil
+}
+
+// FromAny creates an Option[T] that is non-null if v is of type T, or null otherwise
+func FromAny[T any](v any) Option[T] {
+	t, ok := v.(T)
+	if !ok {
+		return New[T]()
+	}
+
+	return From(t)
+}
+
+// FromErrorAs creates an Option[T] with the first error in err's chain that matches T, as found by errors.As.
+// If there is no match, the result is null.
+// Like errors.As, it panics if T is neither an interface nor a type implementing error.
+func FromErrorAs[T any](err error) Option[T] {
+	var target T
+	if !errors.As(err, &target) {
+		return New[T]()
+	}
+
+	return From(target)
+}
+
+// FromContextValue creates an Option[T] that is non-null if ctx.Value(key) is of type T,
+// or null otherwise.
+func FromContextValue[T any](ctx context.Context, key any) Option[T] {
+	v, ok := ctx.Value(key).(T)
+	if !ok {
+		return New[T]()
+	}
+
+	return From(v)
+}
+
 // Ptr returns a pointer to a copy of the value contained by Option.
 // If Option is null, the pointer is nil.
 func (o Option[T]) Ptr() *T {
@@ -60,6 +141,286 @@ func (o Option[T]) Ptr() *T {
 	return &v
 }
 
+// OrZeroPtr returns a pointer to a copy of the value contained by Option.
+// Unlike Ptr, it never returns nil: if Option is null, the pointer is to a new zero value.
+func (o Option[T]) OrZeroPtr() *T {
+	v := o.V
+	if !o.Valid {
+		var zero T
+		v = zero
+	}
+
+	return &v
+}
+
+// Slice returns a slice containing the value of Option, or an empty non-nil slice if Option is null
+func (o Option[T]) Slice() []T {
+	if !o.Valid {
+		return []T{}
+	}
+
+	return []T{o.V}
+}
+
+// NullError is the error returned for a null Option by GetOrError and ToError.
+// Use errors.As to recover it, and errors.Is or errors.As on it to match Err.
+type NullError struct {
+	// TypeName is the name of T, as returned by TypeName
+	TypeName string
+	// Err is the cause of the error, and may be nil
+	Err error
+}
+
+// Error implements error
+func (e *NullError) Error() string {
+	if e.Err == nil {
+		return "opt: null Option[" + e.TypeName + "]"
+	}
+
+	return "opt: null Option[" + e.TypeName + "]: " + e.Err.Error()
+}
+
+// Unwrap returns Err
+func (e *NullError) Unwrap() error {
+	return e.Err
+}
+
+// GetOrError returns the value contained by Option and a nil error.
+// If Option is null, it returns the zero value and a *NullError caused by fmt.Errorf(format, args...).
+func (o Option[T]) GetOrError(format string, args ...any) (T, error) {
+	if !o.Valid {
+		var zero T
+		return zero, &NullError{TypeName: o.TypeName(), Err: fmt.Errorf(format, args...)}
+	}
+
+	return o.V, nil
+}
+
+// ToError returns nil if Option is non-null, or a *NullError without a cause otherwise
+func (o Option[T]) ToError() error {
+	if !o.Valid {
+		return &NullError{TypeName: o.TypeName()}
+	}
+
+	return nil
+}
+
+// Transpose folds an external error into an Option[T].
+// If err != nil, the result is a null Option[T] and err.
+// Otherwise o is returned as is, with a nil error.
+func Transpose[T any](o Option[T], err error) (Option[T], error) {
+	if err != nil {
+		return New[T](), err
+	}
+
+	return o, nil
+}
+
+// Update assigns the value contained by Option to *dst.
+// If Option is null, *dst is left unchanged.
+func (o Option[T]) Update(dst *T) {
+	if !o.Valid {
+		return
+	}
+
+	*dst = o.V
+}
+
+// And returns other if o is non-null, or a null Option[U] otherwise
+func And[T, U any](o Option[T], other Option[U]) Option[U] {
+	if !o.Valid {
+		return New[U]()
+	}
+
+	return other
+}
+
+// MapTry returns From(f(o.V)) if o is non-null, or null otherwise.
+// If f returns an error, the result is null with that error.
+// f is not called if o is null.
+func MapTry[T, U any](o Option[T], f func(T) (U, error)) (Option[U], error) {
+	if !o.Valid {
+		return New[U](), nil
+	}
+
+	v, err := f(o.V)
+	if err != nil {
+		return New[U](), err
+	}
+
+	return From(v), nil
+}
+
+// Deref returns From(*o.V) if o is non-null and o.V != nil, or null otherwise
+func Deref[T any](o Option[*T]) Option[T] {
+	if !o.Valid || o.V == nil {
+		return New[T]()
+	}
+
+	return From(*o.V)
+}
+
+// Ref returns an Option with a pointer to a copy of the value contained by o, or null if o is null
+func Ref[T any](o Option[T]) Option[*T] {
+	if !o.Valid {
+		return New[*T]()
+	}
+
+	return From(o.Ptr())
+}
+
+// Cast converts Option[T] to Option[U] using a Go type conversion, such as from float64 to type Celsius float64.
+// It panics if T is not convertible to U, even if o is null.
+// Like the conversion itself, it also panics if o holds a slice that is shorter than the array or array pointer type U.
+func Cast[T, U any](o Option[T]) Option[U] {
+	from, to := reflect.TypeOf(&o.V).Elem(), reflect.TypeOf((*U)(nil)).Elem()
+	if !from.ConvertibleTo(to) {
+		panic(fmt.Sprintf("opt: cannot convert %s to %s", getTypeName(from), getTypeName(to)))
+	}
+
+	if !o.Valid {
+		return New[U]()
+	}
+
+	v := reflect.ValueOf(&o.V).Elem()
+	if sliceTooShort(v, to) {
+		panic(fmt.Sprintf("opt: cannot convert %s of length %d to %s", getTypeName(from), v.Len(), getTypeName(to)))
+	}
+
+	return From(v.Convert(to).Interface().(U))
+}
+
+// sliceTooShort returns true if v is a slice that is shorter than the array or array pointer type to,
+// in which case converting v to to panics
+func sliceTooShort(v reflect.Value, to reflect.Type) bool {
+	if v.Kind() != reflect.Slice {
+		return false
+	}
+
+	if to.Kind() == reflect.Pointer {
+		to = to.Elem()
+	}
+
+	return to.Kind() == reflect.Array && v.Len() < to.Len()
+}
+
+// TryCast is like Cast, but returns false instead of panicking if T is not convertible to U,
+// or if a slice is shorter than the array or array pointer type U.
+// A null o always returns a null Option[U] and true, since there is no value to convert.
+func TryCast[T, U any](o Option[T]) (Option[U], bool) {
+	if !o.Valid {
+		return New[U](), true
+	}
+
+	v, to := reflect.ValueOf(&o.V).Elem(), reflect.TypeOf((*U)(nil)).Elem()
+	if !v.Type().ConvertibleTo(to) {
+		return New[U](), false
+	}
+
+	if sliceTooShort(v, to) {
+		return New[U](), false
+	}
+
+	return From(v.Convert(to).Interface().(U)), true
+}
+
+// Map2 returns From(f(a.V, b.V)) if a and b are both non-null, or null otherwise
+func Map2[A, B, R any](a Option[A], b Option[B], f func(A, B) R) Option[R] {
+	if !a.Valid || !b.Valid {
+		return New[R]()
+	}
+
+	return From(f(a.V, b.V))
+}
+
+// Map3 returns From(f(a.V, b.V, c.V)) if a, b and c are all non-null, or null otherwise
+func Map3[A, B, C, R any](a Option[A], b Option[B], c Option[C], f func(A, B, C) R) Option[R] {
+	if !a.Valid || !b.Valid || !c.Valid {
+		return New[R]()
+	}
+
+	return From(f(a.V, b.V, c.V))
+}
+
+// OrFunc returns Option if it is non-null.
+// Otherwise it calls f and returns its result.
+// Since the result is an Option, calls can be chained: in o.OrFunc(a).OrFunc(b),
+// each supplier is only called if every Option before it is null.
+func (o Option[T]) OrFunc(f func() Option[T]) Option[T] {
+	if o.Valid {
+		return o
+	}
+
+	return f()
+}
+
+// GetOrCompute returns the value contained by Option and true.
+// If Option is null, it calls f and returns its result and false, so the caller knows the value was computed.
+func (o Option[T]) GetOrCompute(f func() T) (T, bool) {
+	if o.Valid {
+		return o.V, true
+	}
+
+	return f(), false
+}
+
+// ApplyTo is equivalent to o.Update(dst)
+func ApplyTo[T any](dst *T, o Option[T]) {
+	o.Update(dst)
+}
+
+// ApplyToPtr sets *dst to a pointer to a copy of the value contained by o.
+// Unlike ApplyTo, a null Option sets *dst to nil.
+func ApplyToPtr[T any](dst **T, o Option[T]) {
+	*dst = o.Ptr()
+}
+
+// ForEach calls f with the value contained by Option.
+// If Option is null, f is not called.
+func (o Option[T]) ForEach(f func(T)) {
+	if !o.Valid {
+		return
+	}
+
+	f(o.V)
+}
+
+// ToAny converts Option[T] to Option[any], boxing the contained value.
+// A null Option stays null.
+func (o Option[T]) ToAny() Option[any] {
+	if !o.Valid {
+		return New[any]()
+	}
+
+	return From[any](o.V)
+}
+
+// Changed returns true if old and new differ in validity, or are both non-null with different values
+func Changed[T comparable](old, new Option[T]) bool {
+	if old.Valid != new.Valid {
+		return true
+	}
+
+	return old.Valid && old.V != new.V
+}
+
+// OrZeroDefault returns From(def) if o is non-null with the zero value, such as a 0 meaning "unset".
+// Otherwise, o is returned unchanged, so a null o stays null.
+// It is a function rather than a method, because it requires T to be comparable.
+func OrZeroDefault[T comparable](o Option[T], def T) Option[T] {
+	var zero T
+	if o.Valid && o.V == zero {
+		return From(def)
+	}
+
+	return o
+}
+
+// Swap exchanges the contents of a and b
+func Swap[T any](a, b *Option[T]) {
+	*a, *b = *b, *a
+}
+
 // String implements fmt.Stringer
 func (o Option[T]) String() string {
 	if !o.Valid {
@@ -69,10 +430,34 @@ func (o Option[T]) String() string {
 	return fmt.Sprint(o.V)
 }
 
+// StringOr returns nullText if Option is null.
+// Otherwise it returns the value formatted by fmt.Sprint.
+func (o Option[T]) StringOr(nullText string) string {
+	if !o.Valid {
+		return nullText
+	}
+
+	return fmt.Sprint(o.V)
+}
+
+// Debug returns <null> if Option is null, or <set:v> otherwise.
+// Unlike String, the output is unambiguous about whether Option is null.
+func (o Option[T]) Debug() string {
+	if !o.Valid {
+		return "<null>"
+	}
+
+	return fmt.Sprintf("<set:%v>", o.V)
+}
+
 // GoString implements fmt.GoStringer
 func (o Option[T]) GoString() string {
+	if s, ok := o.goStringBasic(); ok {
+		return s
+	}
+
 	if !o.Valid {
-		return fmt.Sprintf("opt.New[%s]()", getTypeName(reflect.TypeOf(&o.V).Elem()))
+		return fmt.Sprintf("opt.New[%s]()", o.TypeName())
 	}
 
 	// for interfaces we need to explicitly mention the type since it cannot be inferred
@@ -83,19 +468,191 @@ func (o Option[T]) GoString() string {
 	return fmt.Sprintf("opt.From(%#v)", o.V)
 }
 
+// GoStringIndent is like GoString, but breaks composite literals over multiple lines with tab indentation,
+// similar to gofmt. This makes large nested structs readable, such as in failing tests.
+func (o Option[T]) GoStringIndent() string {
+	return indentGoSyntax(o.GoString())
+}
+
+// indentGoSyntax puts each element of every non-empty composite literal in s on its own line,
+// followed by a comma, and adds a space after each key.
+// String and rune literals, and function calls such as time.Date(...), are left untouched.
+func indentGoSyntax(s string) string {
+	var b strings.Builder
+	var stack []byte
+
+	newline := func() {
+		b.WriteByte('\n')
+		for _, c := range stack {
+			if c == '{' {
+				b.WriteByte('\t')
+			}
+		}
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '"', '\'', '`':
+			j := goLiteralEnd(s, i)
+			b.WriteString(s[i : j+1])
+			i = j
+			continue
+		case '(', '[':
+			stack = append(stack, c)
+		case ')', ']':
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		case '{':
+			// the fields or methods of a type such as struct { A int } are not a composite literal
+			if strings.HasSuffix(s[:i], "struct ") || strings.HasSuffix(s[:i], "interface ") {
+				j := goBraceEnd(s, i)
+				b.WriteString(s[i : j+1])
+				i = j
+				continue
+			}
+			if i+1 < len(s) && s[i+1] == '}' {
+				b.WriteString("{}")
+				i++
+				continue
+			}
+			stack = append(stack, c)
+			b.WriteByte(c)
+			newline()
+			continue
+		case '}':
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+			b.WriteByte(',')
+			newline()
+		case ',':
+			if len(stack) > 0 && stack[len(stack)-1] == '{' {
+				b.WriteByte(c)
+				newline()
+				if i+1 < len(s) && s[i+1] == ' ' {
+					i++
+				}
+				continue
+			}
+		case ':':
+			if len(stack) > 0 && stack[len(stack)-1] == '{' {
+				b.WriteString(": ")
+				continue
+			}
+		}
+
+		b.WriteByte(c)
+	}
+
+	return b.String()
+}
+
+// goLiteralEnd returns the index of the quote closing the string or rune literal that starts at s[i],
+// or the last index of s if it is unterminated
+func goLiteralEnd(s string, i int) int {
+	q := s[i]
+	j := i + 1
+	for j < len(s) && s[j] != q {
+		if s[j] == '\\' && q != '`' {
+			j++
+		}
+		j++
+	}
+	if j >= len(s) {
+		return len(s) - 1
+	}
+
+	return j
+}
+
+// goBraceEnd returns the index of the brace matching the one at s[i], skipping literals,
+// or the last index of s if it is unmatched
+func goBraceEnd(s string, i int) int {
+	depth := 0
+	for j := i; j < len(s); j++ {
+		switch s[j] {
+		case '"', '\'', '`':
+			j = goLiteralEnd(s, j)
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+
+	return len(s) - 1
+}
+
+// goStringBasic is a reflect-free fast path of GoString for common basic types.
+// The type switch is on a pointer, so that only T itself matches, and not an interface holding it.
+func (o Option[T]) goStringBasic() (string, bool) {
+	var name, value string
+
+	switch v := any(&o.V).(type) {
+	case *int:
+		name, value = "int", strconv.FormatInt(int64(*v), 10)
+	case *int64:
+		name, value = "int64", strconv.FormatInt(*v, 10)
+	case *string:
+		name, value = "string", strconv.Quote(*v)
+	case *bool:
+		name, value = "bool", strconv.FormatBool(*v)
+	case *float64:
+		name, value = "float64", strconv.FormatFloat(*v, 'g', -1, 64)
+	default:
+		return "", false
+	}
+
+	if !o.Valid {
+		return "opt.New[" + name + "]()", true
+	}
+
+	return "opt.From(" + value + ")", true
+}
+
+// Format implements fmt.Formatter.
+// %#v uses GoString, and %v, %s and %q use String.
+// Other verbs are applied to the value, or to the text null if Option is null.
+// Flags such as width and alignment are honored in all cases, so null lines up with present values.
+func (o Option[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), o.GoString())
+	case verb == 'v' || verb == 's' || verb == 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), o.String())
+	case !o.Valid:
+		width, _ := f.Width()
+		if f.Flag('-') {
+			width = -width
+		}
+		fmt.Fprintf(f, "%*s", width, o.String())
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), o.V)
+	}
+}
+
 func getTypeName(t reflect.Type) string {
 	name := t.Name()
 	if name == "" {
-		return fmt.Sprintf("%T", reflect.New(t).Elem().Interface())
+		return t.String()
 	}
 
 	path := t.PkgPath()
-	i := strings.LastIndex(path, "/")
-	if i == -1 {
+	if path == "" {
 		return name
 	}
 
-	return path[i+1:] + "." + name
+	return path[strings.LastIndex(path, "/")+1:] + "." + name
+}
+
+// TypeName returns the name of T, as used by GoString, such as int, sql.NullInt64 or interface {}
+func (o Option[T]) TypeName() string {
+	return getTypeName(reflect.TypeOf(&o.V).Elem())
 }
 
 // IsNull returns true if the value is null.
@@ -104,13 +661,70 @@ func (o Option[T]) IsNull() bool {
 	return !o.Valid
 }
 
+// IsValid returns true if the value is not null.
+// It is the counterpart of IsNull, and equivalent to reading Valid.
+func (o Option[T]) IsValid() bool {
+	return o.Valid
+}
+
+// AnyNull returns true if any of vs is null
+func AnyNull(vs ...Nullable) bool {
+	for _, v := range vs {
+		if v.IsNull() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RequireAll returns an error naming the first null value in required, or nil if none are null.
+// Names are checked in sorted order, so the error is deterministic.
+func RequireAll(required map[string]Nullable) error {
+	names := make([]string, 0, len(required))
+	for name := range required {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if required[name].IsNull() {
+			return fmt.Errorf("opt: %s is required", name)
+		}
+	}
+
+	return nil
+}
+
+// Reset sets Option to null in place
+func (o *Option[T]) Reset() {
+	*o = New[T]()
+}
+
 // MarshalJSON implements json.Marshaler
 func (o Option[T]) MarshalJSON() ([]byte, error) {
 	if !o.Valid {
+		if f := onMarshalNull.Load(); f != nil {
+			(*f)(o.TypeName())
+		}
+
 		return []byte("null"), nil
 	}
 
-	return json.Marshal(o.V)
+	// emit raw messages verbatim
+	if raw, ok := any(o.V).(json.RawMessage); ok && raw != nil {
+		return raw, nil
+	}
+
+	// a present slice is never null, so a nil slice is encoded as empty, unless T encodes itself
+	if _, ok := any(&o.V).(json.Marshaler); !ok {
+		if rv := reflect.ValueOf(&o.V).Elem(); rv.Kind() == reflect.Slice && rv.IsNil() {
+			rv.Set(reflect.MakeSlice(rv.Type(), 0, 0))
+		}
+	}
+
+	// marshal a pointer to the copy held by o, so json.Marshaler is also respected when implemented on *T
+	return json.Marshal(&o.V)
 }
 
 // UnmarshalJSON implements json.Unmarshaler
@@ -126,16 +740,32 @@ func (o *Option[T]) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(data, &o.V)
 }
 
+// JSONString returns the compact JSON encoding of Option as a string, for ad-hoc logging.
+// If encoding fails, the error text is returned instead.
+func (o Option[T]) JSONString() string {
+	data, err := json.Marshal(o)
+	if err != nil {
+		return err.Error()
+	}
+
+	return string(data)
+}
+
 // Value implements driver.Valuer
 func (o Option[T]) Value() (driver.Value, error) {
 	if !o.Valid {
 		return nil, nil
 	}
 
-	return driver.DefaultParameterConverter.ConvertValue(o.V)
+	// convert a pointer to the copy held by o, so driver.Valuer is also respected when implemented on *T
+	return driver.DefaultParameterConverter.ConvertValue(&o.V)
 }
 
-// Scan implements sql.Scanner
+// Scan implements sql.Scanner.
+//
+// If T is an interface type, data is stored as is when its dynamic type implements T,
+// and []byte sources are copied, since drivers may reuse them. For T = any this is always the case.
+// Sources that do not implement T return an error.
 func (o *Option[T]) Scan(data any) error {
 	*o = New[T]()
 
@@ -152,7 +782,18 @@ func (o *Option[T]) Scan(data any) error {
 //   - rows argument removed and any logic associated with it
 //   - switch cases for sql.RawBytes removed
 //   - nil checks removed, since we never pass a nil pointer
+//   - switch cases for complex and byte array destinations added
+//   - unsupported Scan error names the destination type using getTypeName
+//   - integer destinations use asIntegerString, so integral floats are accepted and others rejected
+//   - parsers registered with RegisterParser take precedence for string and []byte sources
+//   - switch case for json.Number destinations added, which only accept valid JSON numbers
+//   - destinations with a SetString method, such as *big.Int, are scanned through it using scanSetString
+//   - destinations implementing encoding.TextUnmarshaler, such as netip.Addr, unmarshal unassignable string and []byte sources
 func scanAssign(dest, src any) error {
+	if ok, err := scanParser(dest, src); ok {
+		return err
+	}
+
 	// Common cases, without reflect.
 	switch s := src.(type) {
 	case string:
@@ -210,6 +851,8 @@ func scanAssign(dest, src any) error {
 			return nil
 		}
 	case *bool:
+		// like sql.NullBool: integers 0 and 1, strings accepted by strconv.ParseBool and bools are converted,
+		// anything else, including floats such as 1.0, is an error
 		bv, err := driver.Bool.ConvertValue(src)
 		if err == nil {
 			*d = bv.(bool)
@@ -218,12 +861,30 @@ func scanAssign(dest, src any) error {
 	case *any:
 		*d = src
 		return nil
+	case *json.Number:
+		sv = reflect.ValueOf(src)
+		switch sv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64,
+			reflect.String, reflect.Slice:
+			s := asString(src)
+			if !isJSONNumber(s) {
+				return fmt.Errorf("converting driver.Value type %T (%q) to a json.Number: invalid syntax", src, s)
+			}
+			*d = json.Number(s)
+			return nil
+		}
 	}
 
 	if scanner, ok := dest.(sql.Scanner); ok {
 		return scanner.Scan(src)
 	}
 
+	if ok, err := scanSetString(dest, src); ok {
+		return err
+	}
+
 	dpv := reflect.ValueOf(dest)
 
 	if !sv.IsValid() {
@@ -241,6 +902,16 @@ func scanAssign(dest, src any) error {
 		return nil
 	}
 
+	// only sources that are not directly assignable, so raw bytes still scan into types such as net.IP
+	if u, ok := dest.(encoding.TextUnmarshaler); ok {
+		switch s := src.(type) {
+		case string:
+			return u.UnmarshalText([]byte(s))
+		case []byte:
+			return u.UnmarshalText(s)
+		}
+	}
+
 	if dv.Kind() == sv.Kind() && sv.Type().ConvertibleTo(dv.Type()) {
 		dv.Set(sv.Convert(dv.Type()))
 		return nil
@@ -257,13 +928,15 @@ func scanAssign(dest, src any) error {
 			dv.Set(reflect.Zero(dv.Type()))
 			return nil
 		}
+		// allocates one level per call, so multi-level pointers such as **T are handled recursively,
+		// and a pointee implementing sql.Scanner is scanned through its Scan method
 		dv.Set(reflect.New(dv.Type().Elem()))
 		return scanAssign(dv.Interface(), src)
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		if src == nil {
 			return fmt.Errorf("converting NULL to %s is unsupported", dv.Kind())
 		}
-		s := asString(src)
+		s := asIntegerString(src)
 		i64, err := strconv.ParseInt(s, 10, dv.Type().Bits())
 		if err != nil {
 			err = strconvErr(err)
@@ -275,7 +948,7 @@ func scanAssign(dest, src any) error {
 		if src == nil {
 			return fmt.Errorf("converting NULL to %s is unsupported", dv.Kind())
 		}
-		s := asString(src)
+		s := asIntegerString(src)
 		u64, err := strconv.ParseUint(s, 10, dv.Type().Bits())
 		if err != nil {
 			err = strconvErr(err)
@@ -295,6 +968,26 @@ func scanAssign(dest, src any) error {
 		}
 		dv.SetFloat(f64)
 		return nil
+	case reflect.Complex64, reflect.Complex128:
+		if src == nil {
+			return fmt.Errorf("converting NULL to %s is unsupported", dv.Kind())
+		}
+		s := asString(src)
+		c128, err := strconv.ParseComplex(s, dv.Type().Bits())
+		if err != nil {
+			err = strconvErr(err)
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %v", src, s, dv.Kind(), err)
+		}
+		dv.SetComplex(c128)
+		return nil
+	case reflect.Array:
+		if b, ok := src.([]byte); ok && dv.Type().Elem().Kind() == reflect.Uint8 {
+			if len(b) != dv.Len() {
+				return fmt.Errorf("converting driver.Value type %T of length %d to a %s of length %d is unsupported", src, len(b), dv.Kind(), dv.Len())
+			}
+			reflect.Copy(dv, reflect.ValueOf(b))
+			return nil
+		}
 	case reflect.String:
 		if src == nil {
 			return fmt.Errorf("converting NULL to %s is unsupported", dv.Kind())
@@ -309,7 +1002,7 @@ func scanAssign(dest, src any) error {
 		}
 	}
 
-	return fmt.Errorf("unsupported Scan, storing driver.Value type %T into type %T", src, dest)
+	return fmt.Errorf("unsupported Scan, storing driver.Value type %T into type %s", src, getTypeName(dv.Type()))
 }
 
 // scanAssign is a copy of database/sql.asString
@@ -336,6 +1029,28 @@ func asString(src any) string {
 	return fmt.Sprintf("%v", src)
 }
 
+// asIntegerString is like asString, but formats floats without an exponent.
+// This way integral floats such as 1e6 parse as integers, while non-integral floats fail to parse.
+func asIntegerString(src any) string {
+	rv := reflect.ValueOf(src)
+	switch rv.Kind() {
+	case reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64)
+	case reflect.Float32:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 32)
+	}
+	return asString(src)
+}
+
+// isJSONNumber returns true if s is a valid JSON number literal
+func isJSONNumber(s string) bool {
+	if s == "" || (s[0] != '-' && (s[0] < '0' || s[0] > '9')) {
+		return false
+	}
+
+	return json.Valid([]byte(s))
+}
+
 // scanAssign is a copy of database/sql.asBytes
 func asBytes(buf []byte, rv reflect.Value) (b []byte, ok bool) {
 	switch rv.Kind() {
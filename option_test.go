@@ -2,11 +2,14 @@ package opt_test
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,12 +27,527 @@ func TestOption(t *testing.T) {
 		assertEq(t, *opt.From(1).Ptr(), 1)
 	})
 
+	t.Run("OrZeroPtr", func(t *testing.T) {
+		p := opt.New[int]().OrZeroPtr()
+		assertEq(t, p != nil, true)
+		assertEq(t, *p, 0)
+
+		// a null Option may still hold a value in V, which must not leak
+		p = opt.Option[int]{V: 5}.OrZeroPtr()
+		assertEq(t, *p, 0)
+
+		o := opt.From(3)
+		p = o.OrZeroPtr()
+		assertEq(t, *p, 3)
+		*p = 4
+		assertEq(t, o, opt.From(3))
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		v := 1
+		opt.New[int]().Update(&v)
+		assertEq(t, v, 1)
+
+		opt.From(0).Update(&v)
+		assertEq(t, v, 0)
+
+		opt.From(2).Update(&v)
+		assertEq(t, v, 2)
+	})
+
+	t.Run("ApplyTo", func(t *testing.T) {
+		v := 1
+		opt.ApplyTo(&v, opt.New[int]())
+		assertEq(t, v, 1)
+
+		opt.ApplyTo(&v, opt.From(2))
+		assertEq(t, v, 2)
+	})
+
+	t.Run("ApplyToPtr", func(t *testing.T) {
+		var p *int
+		opt.ApplyToPtr(&p, opt.From(2))
+		assertEq(t, *p, 2)
+
+		opt.ApplyToPtr(&p, opt.New[int]())
+		assertEq(t, p, nil)
+	})
+
+	t.Run("AnyNull", func(t *testing.T) {
+		assertEq(t, opt.AnyNull(), false)
+		assertEq(t, opt.AnyNull(opt.From(1), opt.From("a"), opt.From(TestStruct1{})), false)
+		assertEq(t, opt.AnyNull(opt.From(1), opt.New[string](), opt.From(TestStruct1{})), true)
+		assertEq(t, opt.AnyNull(opt.New[int]()), true)
+
+		var n opt.Nullable = opt.New[sql.Scanner]()
+		assertEq(t, n.IsNull(), true)
+	})
+
+	t.Run("ForEach", func(t *testing.T) {
+		var calls []int
+		f := func(v int) { calls = append(calls, v) }
+
+		opt.New[int]().ForEach(f)
+		assertEq(t, len(calls), 0)
+
+		opt.From(3).ForEach(f)
+		assertEq(t, len(calls), 1)
+		assertEq(t, calls[0], 3)
+	})
+
+	t.Run("ToAny", func(t *testing.T) {
+		assertEq(t, opt.New[int]().ToAny(), opt.New[any]())
+		assertEq(t, opt.From(1).ToAny(), opt.From[any](1))
+
+		o := opt.From(TestStruct1{"hello"}).ToAny()
+		v, ok := o.V.(TestStruct1)
+		assertEq(t, ok, true)
+		assertEq(t, v, TestStruct1{"hello"})
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		o := opt.From(1)
+		o.Reset()
+		assertEq(t, o, opt.New[int]())
+
+		o.Reset()
+		assertEq(t, o, opt.New[int]())
+	})
+
+	t.Run("And", func(t *testing.T) {
+		assertEq(t, opt.And(opt.From(1), opt.From("a")), opt.From("a"))
+		assertEq(t, opt.And(opt.From(1), opt.New[string]()), opt.New[string]())
+		assertEq(t, opt.And(opt.New[int](), opt.From("a")), opt.New[string]())
+		assertEq(t, opt.And(opt.New[int](), opt.New[string]()), opt.New[string]())
+	})
+
+	t.Run("MapTry", func(t *testing.T) {
+		calls := 0
+		atoi := func(s string) (int, error) {
+			calls++
+			return strconv.Atoi(s)
+		}
+
+		o, err := opt.MapTry(opt.New[string](), atoi)
+		assertEq(t, o, opt.New[int]())
+		assertErrorEq(t, err, nil)
+		assertEq(t, calls, 0)
+
+		o, err = opt.MapTry(opt.From("12"), atoi)
+		assertEq(t, o, opt.From(12))
+		assertErrorEq(t, err, nil)
+		assertEq(t, calls, 1)
+
+		o, err = opt.MapTry(opt.From("abc"), atoi)
+		assertEq(t, o, opt.New[int]())
+		assertErrorEq(t, err, errors.New(`strconv.Atoi: parsing "abc": invalid syntax`))
+		assertEq(t, calls, 2)
+	})
+
+	t.Run("Deref", func(t *testing.T) {
+		assertEq(t, opt.Deref(opt.New[*int]()), opt.New[int]())
+		assertEq(t, opt.Deref(opt.From[*int](nil)), opt.New[int]())
+		assertEq(t, opt.Deref(opt.From(ptr(0))), opt.From(0))
+		assertEq(t, opt.Deref(opt.From(ptr(2))), opt.From(2))
+	})
+
+	t.Run("Ref", func(t *testing.T) {
+		assertEq(t, opt.Ref(opt.New[int]()), opt.New[*int]())
+
+		src := opt.From(1)
+		r := opt.Ref(src)
+		assertEq(t, r.Valid, true)
+		assertEq(t, *r.V, 1)
+
+		*r.V = 2
+		assertEq(t, src, opt.From(1))
+		assertEq(t, opt.Deref(r), opt.From(2))
+	})
+
+	t.Run("Cast", func(t *testing.T) {
+		type Celsius float64
+
+		assertEq(t, opt.Cast[float64, Celsius](opt.From(21.5)), opt.From(Celsius(21.5)))
+		assertEq(t, opt.Cast[float64, Celsius](opt.New[float64]()), opt.New[Celsius]())
+		assertEq(t, opt.Cast[Celsius, float64](opt.From(Celsius(-4))), opt.From(-4.0))
+		assertEq(t, opt.Cast[int, float64](opt.From(3)), opt.From(3.0))
+
+		assertEq(t, opt.Cast[[]byte, [2]byte](opt.From([]byte{1, 2, 3})), opt.From([2]byte{1, 2}))
+
+		func() {
+			defer func() {
+				assertEq(t, recover(), any("opt: cannot convert []uint8 of length 1 to [4]uint8"))
+			}()
+			opt.Cast[[]byte, [4]byte](opt.From([]byte{1}))
+			t.Error("expected panic")
+		}()
+
+		defer func() {
+			assertEq(t, recover(), any("opt: cannot convert float64 to opt_test.TestStruct1"))
+		}()
+		opt.Cast[float64, TestStruct1](opt.New[float64]())
+		t.Error("expected panic")
+	})
+
+	t.Run("TryCast", func(t *testing.T) {
+		type Celsius float64
+
+		c, ok := opt.TryCast[float64, Celsius](opt.From(21.5))
+		assertEq(t, ok, true)
+		assertEq(t, c, opt.From(Celsius(21.5)))
+
+		s, ok := opt.TryCast[float64, TestStruct1](opt.From(1.0))
+		assertEq(t, ok, false)
+		assertEq(t, s, opt.New[TestStruct1]())
+
+		s, ok = opt.TryCast[float64, TestStruct1](opt.New[float64]())
+		assertEq(t, ok, true)
+		assertEq(t, s, opt.New[TestStruct1]())
+
+		a, ok := opt.TryCast[[]byte, [4]byte](opt.From([]byte{1}))
+		assertEq(t, ok, false)
+		assertEq(t, a, opt.New[[4]byte]())
+
+		p, ok := opt.TryCast[[]byte, *[4]byte](opt.From([]byte{1}))
+		assertEq(t, ok, false)
+		assertEq(t, p, opt.New[*[4]byte]())
+
+		a, ok = opt.TryCast[[]byte, [4]byte](opt.From([]byte{1, 2, 3, 4}))
+		assertEq(t, ok, true)
+		assertEq(t, a, opt.From([4]byte{1, 2, 3, 4}))
+	})
+
+	t.Run("Map2", func(t *testing.T) {
+		f := func(a int, b string) string { return fmt.Sprint(a, b) }
+
+		assertEq(t, opt.Map2(opt.From(1), opt.From("a"), f), opt.From("1a"))
+		assertEq(t, opt.Map2(opt.From(1), opt.New[string](), f), opt.New[string]())
+		assertEq(t, opt.Map2(opt.New[int](), opt.From("a"), f), opt.New[string]())
+		assertEq(t, opt.Map2(opt.New[int](), opt.New[string](), f), opt.New[string]())
+	})
+
+	t.Run("Map3", func(t *testing.T) {
+		f := func(a int, b string, c bool) TestStruct1 { return TestStruct1{fmt.Sprint(a, b, c)} }
+
+		assertEq(t, opt.Map3(opt.From(1), opt.From("a"), opt.From(true), f), opt.From(TestStruct1{"1atrue"}))
+		assertEq(t, opt.Map3(opt.New[int](), opt.From("a"), opt.From(true), f), opt.New[TestStruct1]())
+		assertEq(t, opt.Map3(opt.From(1), opt.New[string](), opt.From(true), f), opt.New[TestStruct1]())
+		assertEq(t, opt.Map3(opt.From(1), opt.From("a"), opt.New[bool](), f), opt.New[TestStruct1]())
+		assertEq(t, opt.Map3(opt.New[int](), opt.New[string](), opt.New[bool](), f), opt.New[TestStruct1]())
+	})
+
+	t.Run("OrFunc", func(t *testing.T) {
+		var calls [3]int
+		supplier := func(i int, o opt.Option[int]) func() opt.Option[int] {
+			return func() opt.Option[int] {
+				calls[i]++
+				return o
+			}
+		}
+
+		o := opt.New[int]().
+			OrFunc(supplier(0, opt.New[int]())).
+			OrFunc(supplier(1, opt.From(2))).
+			OrFunc(supplier(2, opt.From(3)))
+		assertEq(t, o, opt.From(2))
+		assertEq(t, calls, [3]int{1, 1, 0})
+
+		o = opt.From(1).
+			OrFunc(supplier(0, opt.New[int]())).
+			OrFunc(supplier(1, opt.From(2))).
+			OrFunc(supplier(2, opt.From(3)))
+		assertEq(t, o, opt.From(1))
+		assertEq(t, calls, [3]int{1, 1, 0})
+
+		o = opt.New[int]().
+			OrFunc(supplier(0, opt.New[int]())).
+			OrFunc(supplier(1, opt.New[int]())).
+			OrFunc(supplier(2, opt.New[int]()))
+		assertEq(t, o, opt.New[int]())
+		assertEq(t, calls, [3]int{2, 2, 1})
+	})
+
+	t.Run("GetOrCompute", func(t *testing.T) {
+		calls := 0
+		compute := func() int {
+			calls++
+			return 7
+		}
+
+		v, ok := opt.From(0).GetOrCompute(compute)
+		assertEq(t, v, 0)
+		assertEq(t, ok, true)
+		assertEq(t, calls, 0)
+
+		v, ok = opt.New[int]().GetOrCompute(compute)
+		assertEq(t, v, 7)
+		assertEq(t, ok, false)
+		assertEq(t, calls, 1)
+	})
+
+	t.Run("RequireAll", func(t *testing.T) {
+		assertErrorEq(t, opt.RequireAll(nil), nil)
+		assertErrorEq(t, opt.RequireAll(map[string]opt.Nullable{
+			"id":   opt.From(1),
+			"name": opt.From("a"),
+		}), nil)
+		assertErrorEq(t, opt.RequireAll(map[string]opt.Nullable{
+			"id":      opt.From(1),
+			"name":    opt.New[string](),
+			"created": opt.New[time.Time](),
+			"active":  opt.From(true),
+		}), errors.New("opt: created is required"))
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		assertEq(t, opt.Changed(opt.New[int](), opt.New[int]()), false)
+		assertEq(t, opt.Changed(opt.From(1), opt.From(1)), false)
+		assertEq(t, opt.Changed(opt.From(1), opt.From(2)), true)
+		assertEq(t, opt.Changed(opt.From(0), opt.New[int]()), true)
+		assertEq(t, opt.Changed(opt.New[int](), opt.From(0)), true)
+		assertEq(t, opt.Changed(opt.Option[int]{V: 1}, opt.Option[int]{V: 2}), false)
+	})
+
+	t.Run("OrZeroDefault", func(t *testing.T) {
+		assertEq(t, opt.OrZeroDefault(opt.From(0), 10), opt.From(10))
+		assertEq(t, opt.OrZeroDefault(opt.From(3), 10), opt.From(3))
+		assertEq(t, opt.OrZeroDefault(opt.New[int](), 10), opt.New[int]())
+		assertEq(t, opt.OrZeroDefault(opt.From(""), "x"), opt.From("x"))
+	})
+
+	t.Run("Swap", func(t *testing.T) {
+		a, b := opt.From(1), opt.New[int]()
+		opt.Swap(&a, &b)
+		assertEq(t, a, opt.New[int]())
+		assertEq(t, b, opt.From(1))
+
+		c := opt.From(2)
+		opt.Swap(&b, &c)
+		assertEq(t, b, opt.From(2))
+		assertEq(t, c, opt.From(1))
+	})
+
+	t.Run("Slice", func(t *testing.T) {
+		s := opt.New[int]().Slice()
+		assertEq(t, s != nil, true)
+		assertEq(t, len(s), 0)
+
+		s = opt.From(2).Slice()
+		assertEq(t, len(s), 1)
+		assertEq(t, s[0], 2)
+	})
+
+	t.Run("GetOrError", func(t *testing.T) {
+		v, err := opt.From(1).GetOrError("missing %s", "id")
+		assertEq(t, v, 1)
+		assertErrorEq(t, err, nil)
+
+		v, err = opt.New[int]().GetOrError("missing %s (%d)", "id", 400)
+		assertEq(t, v, 0)
+		assertErrorEq(t, err, errors.New("opt: null Option[int]: missing id (400)"))
+
+		var nullErr *opt.NullError
+		assertEq(t, errors.As(err, &nullErr), true)
+		assertEq(t, nullErr.TypeName, "int")
+		assertErrorEq(t, errors.Unwrap(err), errors.New("missing id (400)"))
+
+		_, err = opt.New[int]().GetOrError("wrapped: %w", sql.ErrNoRows)
+		assertEq(t, errors.Is(err, sql.ErrNoRows), true)
+	})
+
+	t.Run("ToError", func(t *testing.T) {
+		assertErrorEq(t, opt.From(0).ToError(), nil)
+
+		err := opt.New[string]().ToError()
+		assertErrorEq(t, err, errors.New("opt: null Option[string]"))
+
+		var nullErr *opt.NullError
+		assertEq(t, errors.As(err, &nullErr), true)
+		assertEq(t, nullErr.Err, nil)
+		assertEq(t, errors.Unwrap(err), nil)
+	})
+
 	t.Run("IsNull", func(t *testing.T) {
 		assertEq(t, opt.New[int]().IsNull(), true)
 		assertEq(t, opt.From(0).IsNull(), false)
 		assertEq(t, opt.From(1).IsNull(), false)
 	})
 
+	t.Run("IsValid", func(t *testing.T) {
+		for _, o := range []opt.Option[int]{opt.New[int](), opt.From(0), opt.From(1)} {
+			assertEq(t, o.IsValid(), o.Valid)
+		}
+	})
+
+}
+
+func TestFromValid(t *testing.T) {
+	errTest := errors.New("test")
+	calls := 0
+	positive := func(v int) error {
+		calls++
+		if v <= 0 {
+			return errTest
+		}
+		return nil
+	}
+
+	o, err := opt.FromValid(1, positive)
+	assertEq(t, o, opt.From(1))
+	assertErrorEq(t, err, nil)
+	assertEq(t, calls, 1)
+
+	o, err = opt.FromValid(0, positive)
+	assertEq(t, o, opt.New[int]())
+	assertEq(t, err, errTest)
+	assertEq(t, calls, 2)
+}
+
+func TestFromErrFunc(t *testing.T) {
+	var errs []error
+	onErr := func(err error) { errs = append(errs, err) }
+
+	v, err := strconv.Atoi("12")
+	assertEq(t, opt.FromErrFunc(v, err, onErr), opt.From(12))
+	assertEq(t, len(errs), 0)
+
+	v, err = strconv.Atoi("x")
+	assertEq(t, opt.FromErrFunc(v, err, onErr), opt.New[int]())
+	assertEq(t, len(errs), 1)
+	assertEq(t, errs[0], err)
+}
+
+func TestParse(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		o, err := opt.Parse[int]("123")
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.From(123))
+
+		o, err = opt.Parse[int]("")
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.New[int]())
+
+		o, err = opt.Parse[int]("abc")
+		assertErrorEq(t, err, errors.New(`converting driver.Value type string ("abc") to a int: invalid syntax`))
+		assertEq(t, o, opt.New[int]())
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		o, err := opt.Parse[float64]("1.5")
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.From(1.5))
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		o, err := opt.Parse[bool]("true")
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.From(true))
+
+		_, err = opt.Parse[bool]("yes")
+		assertEq(t, err != nil, true)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		o, err := opt.Parse[string]("hello")
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.From("hello"))
+
+		o, err = opt.Parse[string]("")
+		assertErrorEq(t, err, nil)
+		assertEq(t, o, opt.New[string]())
+	})
+}
+
+func TestFromAny(t *testing.T) {
+	config := map[string]any{"port": 8080, "host": "localhost", "empty": nil}
+
+	assertEq(t, opt.FromAny[int](config["port"]), opt.From(8080))
+	assertEq(t, opt.FromAny[string](config["host"]), opt.From("localhost"))
+	assertEq(t, opt.FromAny[string](config["port"]), opt.New[string]())
+	assertEq(t, opt.FromAny[int](config["empty"]), opt.New[int]())
+	assertEq(t, opt.FromAny[int](config["missing"]), opt.New[int]())
+	assertEq(t, opt.FromAny[fmt.Stringer](nil), opt.New[fmt.Stringer]())
+}
+
+type testError struct {
+	Code int
+}
+
+func (e *testError) Error() string { return fmt.Sprintf("test error %d", e.Code) }
+
+func TestFromErrorAs(t *testing.T) {
+	target := &testError{Code: 1}
+	err := fmt.Errorf("wrapped: %w", fmt.Errorf("again: %w", target))
+
+	assertEq(t, opt.FromErrorAs[*testError](err), opt.From(target))
+	assertEq(t, opt.FromErrorAs[*testError](errors.New("other")), opt.New[*testError]())
+	assertEq(t, opt.FromErrorAs[*testError](nil), opt.New[*testError]())
+
+	type errorer interface{ Error() string }
+	assertEq(t, opt.FromErrorAs[errorer](err).Valid, true)
+}
+
+func TestFromContextValue(t *testing.T) {
+	type key struct{}
+	ctx := context.Background()
+
+	assertEq(t, opt.FromContextValue[string](ctx, key{}), opt.New[string]())
+
+	ctx = context.WithValue(ctx, key{}, "hello")
+	assertEq(t, opt.FromContextValue[string](ctx, key{}), opt.From("hello"))
+	assertEq(t, opt.FromContextValue[int](ctx, key{}), opt.New[int]())
+}
+
+func TestTranspose(t *testing.T) {
+	errTest := errors.New("test")
+
+	o, err := opt.Transpose(opt.From(1), nil)
+	assertEq(t, o, opt.From(1))
+	assertErrorEq(t, err, nil)
+
+	o, err = opt.Transpose(opt.New[int](), nil)
+	assertEq(t, o, opt.New[int]())
+	assertErrorEq(t, err, nil)
+
+	o, err = opt.Transpose(opt.From(1), errTest)
+	assertEq(t, o, opt.New[int]())
+	assertEq(t, err, errTest)
+
+	o, err = opt.Transpose(opt.New[int](), errTest)
+	assertEq(t, o, opt.New[int]())
+	assertEq(t, err, errTest)
+}
+
+func TestStringOr(t *testing.T) {
+	assertEq(t, opt.New[int]().StringOr("-"), "-")
+	assertEq(t, opt.New[int]().StringOr("N/A"), "N/A")
+	assertEq(t, opt.New[int]().StringOr(""), "")
+	assertEq(t, opt.From(0).StringOr("-"), "0")
+	assertEq(t, opt.From("hello").StringOr("N/A"), "hello")
+}
+
+func TestFormat(t *testing.T) {
+	assertEq(t, fmt.Sprintf("%-10v|", opt.New[int]()), "null      |")
+	assertEq(t, fmt.Sprintf("%-10v|", opt.From(123)), "123       |")
+	assertEq(t, fmt.Sprintf("%10v|", opt.New[int]()), "      null|")
+	assertEq(t, fmt.Sprintf("%10v|", opt.From(123)), "       123|")
+	assertEq(t, fmt.Sprintf("%s", opt.From("hello")), "hello")
+	assertEq(t, fmt.Sprintf("%q", opt.From("hello")), `"hello"`)
+	assertEq(t, fmt.Sprintf("%q", opt.New[string]()), `"null"`)
+	assertEq(t, fmt.Sprintf("%#v", opt.From(1)), "opt.From(1)")
+	assertEq(t, fmt.Sprintf("%#v", opt.New[int]()), "opt.New[int]()")
+	assertEq(t, fmt.Sprintf("%05d", opt.From(42)), "00042")
+	assertEq(t, fmt.Sprintf("%6.2f|", opt.From(1.5)), "  1.50|")
+	assertEq(t, fmt.Sprintf("%6.2f|", opt.New[float64]()), "  null|")
+	assertEq(t, fmt.Sprintf("%-6d|", opt.New[int]()), "null  |")
+	assertEq(t, fmt.Sprint(opt.From(TestStruct1{"hello"})), "{hello}")
+}
+
+func TestDebug(t *testing.T) {
+	assertEq(t, opt.New[string]().Debug(), "<null>")
+	assertEq(t, opt.From("null").Debug(), "<set:null>")
+	assertEq(t, opt.From("").Debug(), "<set:>")
+	assertEq(t, opt.From(0).Debug(), "<set:0>")
 }
 
 func TestGoString(t *testing.T) {
@@ -51,6 +569,109 @@ func TestGoString(t *testing.T) {
 	// assertEq(t, opt.From(make(chan int)).GoString(), "opt.From((chan int)(0xc0001a4c60))")
 }
 
+func TestGoStringBasic(t *testing.T) {
+	// the fast path for basic types must match fmt's %#v exactly
+	ints := []int{0, 1, -1, 1 << 40}
+	for _, v := range ints {
+		assertEq(t, opt.From(v).GoString(), fmt.Sprintf("opt.From(%#v)", v))
+		assertEq(t, opt.From(int64(v)).GoString(), fmt.Sprintf("opt.From(%#v)", int64(v)))
+	}
+
+	strs := []string{"", "hello", "a\"b", "line\nbreak", "\x00", "ünïcode"}
+	for _, v := range strs {
+		assertEq(t, opt.From(v).GoString(), fmt.Sprintf("opt.From(%#v)", v))
+	}
+
+	floats := []float64{0, 1, -1.5, 1e21, 1e-7, 123456789.123}
+	for _, v := range floats {
+		assertEq(t, opt.From(v).GoString(), fmt.Sprintf("opt.From(%#v)", v))
+	}
+
+	assertEq(t, opt.From(true).GoString(), "opt.From(true)")
+	assertEq(t, opt.From(false).GoString(), "opt.From(false)")
+	assertEq(t, opt.New[int64]().GoString(), "opt.New[int64]()")
+	assertEq(t, opt.New[string]().GoString(), "opt.New[string]()")
+	assertEq(t, opt.New[bool]().GoString(), "opt.New[bool]()")
+	assertEq(t, opt.New[float64]().GoString(), "opt.New[float64]()")
+	assertEq(t, opt.From[driver.Value](1).GoString(), "opt.From[driver.Value](1)")
+}
+
+func TestGoStringIndent(t *testing.T) {
+	type inner struct {
+		S    string
+		Tags []string
+	}
+	type outer struct {
+		N     int
+		In    inner
+		M     map[string]int
+		Empty []int
+	}
+
+	o := opt.From(outer{N: 1, In: inner{S: "a, {b}: c", Tags: []string{"x", "y"}}, M: map[string]int{"k": 2}, Empty: []int{}})
+	assertEq(t, o.GoStringIndent(), `opt.From(opt_test.outer{
+	N: 1,
+	In: opt_test.inner{
+		S: "a, {b}: c",
+		Tags: []string{
+			"x",
+			"y",
+		},
+	},
+	M: map[string]int{
+		"k": 2,
+	},
+	Empty: []int{},
+})`)
+
+	assertEq(t, opt.From(struct{ A int }{1}).GoStringIndent(), `opt.From(struct { A int }{
+	A: 1,
+})`)
+
+	anon := opt.From(struct {
+		B struct {
+			C string `json:"c,omitempty"`
+		}
+		D []any
+	}{D: []any{1, "x"}})
+	anon.V.B.C = "}"
+	assertEq(t, anon.GoStringIndent(), `opt.From(struct { B struct { C string "json:\"c,omitempty\"" }; D []interface {} }{
+	B: struct { C string "json:\"c,omitempty\"" }{
+		C: "}",
+	},
+	D: []interface {}{
+		1,
+		"x",
+	},
+})`)
+
+	assertEq(t, opt.New[outer]().GoStringIndent(), "opt.New[opt_test.outer]()")
+	assertEq(t, opt.From(1).GoStringIndent(), "opt.From(1)")
+}
+
+func BenchmarkGoString(b *testing.B) {
+	o := opt.From(123)
+	for i := 0; i < b.N; i++ {
+		_ = o.GoString()
+	}
+}
+
+func TestTypeName(t *testing.T) {
+	assertEq(t, opt.New[int]().TypeName(), "int")
+	assertEq(t, opt.From(1).TypeName(), "int")
+	assertEq(t, opt.New[TestStruct1]().TypeName(), "opt_test.TestStruct1")
+	assertEq(t, opt.New[sql.NullInt64]().TypeName(), "sql.NullInt64")
+	assertEq(t, opt.New[sql.Scanner]().TypeName(), "sql.Scanner")
+	assertEq(t, opt.From[sql.Scanner](&sql.NullInt64{}).TypeName(), "sql.Scanner")
+	assertEq(t, opt.New[fmt.Stringer]().TypeName(), "fmt.Stringer")
+	assertEq(t, opt.New[any]().TypeName(), "interface {}")
+	assertEq(t, opt.New[[]string]().TypeName(), "[]string")
+	assertEq(t, opt.New[map[string]TestStruct1]().TypeName(), "map[string]opt_test.TestStruct1")
+
+	assertEq(t, opt.New[fmt.Stringer]().GoString(), "opt.New[fmt.Stringer]()")
+	assertEq(t, opt.From[any](1).GoString(), "opt.From[interface {}](1)")
+}
+
 func TestOptionInt64(t *testing.T) {
 	t.Run("sql.Scanner", func(t *testing.T) {
 		cases := []any{
@@ -483,6 +1104,48 @@ func TestOptionBool(t *testing.T) {
 		}
 	})
 
+	t.Run("sql.Scanner numeric and string", func(t *testing.T) {
+		cases := []struct {
+			src    any
+			result bool
+			err    bool
+		}{
+			{src: int64(0), result: false},
+			{src: int64(1), result: true},
+			{src: int64(2), err: true},
+			{src: int64(-1), err: true},
+			{src: uint8(1), result: true},
+			{src: float64(0.0), err: true},
+			{src: float64(1.0), err: true},
+			{src: float64(1.1), err: true},
+			{src: "0", result: false},
+			{src: "1", result: true},
+			{src: "t", result: true},
+			{src: "F", result: false},
+			{src: "true", result: true},
+			{src: "FALSE", result: false},
+			{src: "yes", err: true},
+			{src: []byte("1"), result: true},
+		}
+
+		for _, c := range cases {
+			t.Run(fmt.Sprintf("%T(%v)", c.src, c.src), func(t *testing.T) {
+				var sqlBool sql.NullBool
+				var optBool opt.Option[bool]
+
+				sqlErr := sqlBool.Scan(c.src)
+				optErr := optBool.Scan(c.src)
+
+				assertErrorEq(t, optErr, sqlErr)
+				assertEq(t, optErr != nil, c.err)
+				assertEq(t, optBool.V, sqlBool.Bool)
+				if !c.err {
+					assertEq(t, optBool, opt.From(c.result))
+				}
+			})
+		}
+	})
+
 	t.Run("driver.Valuer", func(t *testing.T) {
 		cases := []struct {
 			sql    sql.NullBool
@@ -614,6 +1277,79 @@ func (t *TestStruct2) Scan(data any) error {
 	return errors.New("scan failed")
 }
 
+type TestStruct3 struct {
+	V string
+}
+
+var _ json.Marshaler = &TestStruct3{}
+
+func (t *TestStruct3) MarshalJSON() ([]byte, error) {
+	return json.Marshal("ptr:" + t.V)
+}
+
+func TestOptionStruct3(t *testing.T) {
+	t.Run("json.Marshaler", func(t *testing.T) {
+		data, err := json.Marshal(opt.From(TestStruct3{"hello"}))
+		assertErrorEq(t, err, nil)
+		assertBytesEq(t, data, []byte(`"ptr:hello"`))
+
+		data, err = json.Marshal(opt.New[TestStruct3]())
+		assertErrorEq(t, err, nil)
+		assertBytesEq(t, data, []byte(`null`))
+	})
+}
+
+func TestJSONString(t *testing.T) {
+	for _, o := range []opt.Option[any]{
+		opt.New[any](),
+		opt.From[any](1),
+		opt.From[any]("hello"),
+		opt.From[any](map[string][]int{"a": {1, 2}}),
+		opt.From[any](TestStruct1{"hello"}),
+	} {
+		data, err := json.Marshal(o.V)
+		if o.IsNull() {
+			data = []byte("null")
+		}
+		assertErrorEq(t, err, nil)
+		assertEq(t, o.JSONString(), string(data))
+	}
+
+	assertEq(t, strings.HasSuffix(opt.From(func() {}).JSONString(), "json: unsupported type: func()"), true)
+}
+
+type TestStruct4 struct {
+	V string
+}
+
+var _ driver.Valuer = &TestStruct4{}
+
+func (t *TestStruct4) Value() (driver.Value, error) {
+	return "ptr:" + t.V, nil
+}
+
+func TestOptionStruct4(t *testing.T) {
+	t.Run("driver.Valuer", func(t *testing.T) {
+		v, err := opt.From(TestStruct4{"hello"}).Value()
+		assertErrorEq(t, err, nil)
+		assertEq(t, v, driver.Value("ptr:hello"))
+
+		v, err = opt.New[TestStruct4]().Value()
+		assertErrorEq(t, err, nil)
+		assertEq(t, v, nil)
+	})
+
+	t.Run("value receiver", func(t *testing.T) {
+		v, err := opt.From(TestStruct2{"hello"}).Value()
+		assertErrorEq(t, err, nil)
+		assertEq(t, v, driver.Value("hello"))
+
+		v, err = opt.From[*TestStruct2](nil).Value()
+		assertErrorEq(t, err, nil)
+		assertEq(t, v, nil)
+	})
+}
+
 func TestOptionStruct1(t *testing.T) {
 	t.Run("driver.Valuer", func(t *testing.T) {
 		cases := []*TestStruct1{
@@ -769,6 +1505,32 @@ func TestOptionScanAssign(t *testing.T) {
 		assertEq(t, string(o.V), "hello")
 	})
 
+	t.Run("empty []byte to string", func(t *testing.T) {
+		for _, src := range [][]byte{{}, nil} {
+			var sqlStr sql.NullString
+			o := opt.New[string]()
+
+			assertErrorEq(t, o.Scan(src), sqlStr.Scan(src))
+			assertEq(t, o, opt.From(""))
+			assertEq(t, o.Valid, sqlStr.Valid)
+		}
+	})
+
+	t.Run("empty []byte to []byte", func(t *testing.T) {
+		o := opt.New[[]byte]()
+		if err := o.Scan([]byte{}); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Valid, true)
+		assertBytesEq(t, o.V, []byte{})
+
+		if err := o.Scan([]byte(nil)); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Valid, true)
+		assertBytesEq(t, o.V, nil)
+	})
+
 	t.Run("[]byte to any", func(t *testing.T) {
 		o := opt.New[any]()
 		if err := o.Scan([]byte("hello")); err != nil {
@@ -811,6 +1573,169 @@ func TestOptionScanAssign(t *testing.T) {
 		assertEq(t, o.V, sql.NullInt64{Valid: true, Int64: 1})
 	})
 
+	t.Run("integral float64 to int", func(t *testing.T) {
+		for _, f := range []float64{2.0, -3.0, 1e6} {
+			o := opt.New[int]()
+			if err := o.Scan(f); err != nil {
+				t.Error(err)
+			}
+			assertEq(t, o.V, int(f))
+		}
+	})
+
+	t.Run("non-integral float64 to int", func(t *testing.T) {
+		o := opt.New[int]()
+		assertErrorEq(t, o.Scan(2.5), errors.New(`converting driver.Value type float64 ("2.5") to a int: invalid syntax`))
+
+		u := opt.New[uint]()
+		assertErrorEq(t, u.Scan(2.5), errors.New(`converting driver.Value type float64 ("2.5") to a uint: invalid syntax`))
+	})
+
+	t.Run("out of range float64 to int8", func(t *testing.T) {
+		o := opt.New[int8]()
+		assertErrorEq(t, o.Scan(1e3), errors.New(`converting driver.Value type float64 ("1000") to a int8: value out of range`))
+	})
+
+	t.Run("string to int32", func(t *testing.T) {
+		o := opt.New[int32]()
+		if err := o.Scan("5"); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.V, 5)
+
+		assertErrorEq(t, o.Scan("x"), errors.New(`converting driver.Value type string ("x") to a int32: invalid syntax`))
+	})
+
+	t.Run("json.Number", func(t *testing.T) {
+		cases := []struct {
+			src    any
+			result json.Number
+		}{
+			{src: int64(42), result: "42"},
+			{src: "3.14", result: "3.14"},
+			{src: []byte("-1e10"), result: "-1e10"},
+			{src: float64(0.5), result: "0.5"},
+			{src: "12345678901234567890123", result: "12345678901234567890123"},
+		}
+
+		for _, c := range cases {
+			o := opt.New[json.Number]()
+			if err := o.Scan(c.src); err != nil {
+				t.Error(err)
+			}
+			assertEq(t, o, opt.From(c.result))
+		}
+
+		o := opt.From(json.Number("1"))
+		if err := o.Scan(nil); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o, opt.New[json.Number]())
+
+		assertErrorEq(t, o.Scan("abc"), errors.New(`converting driver.Value type string ("abc") to a json.Number: invalid syntax`))
+		assertErrorEq(t, o.Scan("1 2"), errors.New(`converting driver.Value type string ("1 2") to a json.Number: invalid syntax`))
+	})
+
+	t.Run("string to complex128", func(t *testing.T) {
+		o := opt.New[complex128]()
+		if err := o.Scan("(1+2i)"); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.V, 1+2i)
+	})
+
+	t.Run("[]byte to complex64", func(t *testing.T) {
+		o := opt.New[complex64]()
+		if err := o.Scan([]byte("(1+2i)")); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.V, 1+2i)
+	})
+
+	t.Run("invalid string to complex128", func(t *testing.T) {
+		o := opt.New[complex128]()
+		assertErrorEq(t, o.Scan("hello"), errors.New(`converting driver.Value type string ("hello") to a complex128: invalid syntax`))
+	})
+
+	t.Run("[]byte to [N]byte", func(t *testing.T) {
+		o := opt.New[[4]byte]()
+		if err := o.Scan([]byte{1, 2, 3, 4}); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.V, [4]byte{1, 2, 3, 4})
+	})
+
+	t.Run("[]byte to [N]byte length mismatch", func(t *testing.T) {
+		o := opt.New[[4]byte]()
+		assertErrorEq(t, o.Scan([]byte{1, 2, 3}), errors.New("converting driver.Value type []uint8 of length 3 to a array of length 4 is unsupported"))
+	})
+
+	t.Run("[]byte to [16]byte", func(t *testing.T) {
+		uuid := []byte{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}
+
+		o := opt.New[[16]byte]()
+		if err := o.Scan(uuid); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o, opt.From([16]byte(uuid)))
+
+		assertErrorEq(t, o.Scan(uuid[:15]), errors.New("converting driver.Value type []uint8 of length 15 to a array of length 16 is unsupported"))
+
+		if err := o.Scan(nil); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o, opt.New[[16]byte]())
+	})
+
+	t.Run("int64 to *int", func(t *testing.T) {
+		o := opt.New[*int]()
+		if err := o.Scan(int64(5)); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Valid, true)
+		assertEq(t, *o.V, 5)
+	})
+
+	t.Run("int64 to **int", func(t *testing.T) {
+		o := opt.New[**int]()
+		if err := o.Scan(int64(5)); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Valid, true)
+		assertEq(t, **o.V, 5)
+	})
+
+	t.Run("int to *sql.NullInt64", func(t *testing.T) {
+		o := opt.New[*sql.NullInt64]()
+		if err := o.Scan(int64(7)); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Valid, true)
+		assertEq(t, o.V != nil, true)
+		assertEq(t, *o.V, sql.NullInt64{Valid: true, Int64: 7})
+
+		if err := o.Scan(nil); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o, opt.New[*sql.NullInt64]())
+	})
+
+	t.Run("NULL to *int", func(t *testing.T) {
+		o := opt.From(ptr(1))
+		if err := o.Scan(nil); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o, opt.New[*int]())
+	})
+
+	t.Run("unsupported error names type", func(t *testing.T) {
+		o := opt.New[TestStruct1]()
+		assertErrorEq(t, o.Scan(int64(1)), errors.New("unsupported Scan, storing driver.Value type int64 into type opt_test.TestStruct1"))
+
+		o2 := opt.New[opt.Option[TestStruct1]]()
+		assertErrorEq(t, o2.Scan(int64(1)), errors.New("unsupported Scan, storing driver.Value type int64 into type opt_test.TestStruct1"))
+	})
+
 	t.Run("bytes assignable", func(t *testing.T) {
 		o := opt.New[json.RawMessage]()
 		if err := o.Scan([]byte("hello")); err != nil {
@@ -0,0 +1,8 @@
+module github.com/FallenTaters/opt/optpb
+
+go 1.20
+
+require (
+	github.com/FallenTaters/opt v1.0.0
+	google.golang.org/protobuf v1.34.2
+)
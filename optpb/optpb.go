@@ -0,0 +1,170 @@
+// Package optpb converts between opt.Option and the protobuf well-known wrapper types.
+// It is a separate module, so that opt itself does not depend on protobuf.
+package optpb
+
+import (
+	"github.com/FallenTaters/opt"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// FromDoubleValue creates an Option[float64] that is null if w == nil, or non-null with w's value otherwise
+func FromDoubleValue(w *wrapperspb.DoubleValue) opt.Option[float64] {
+	if w == nil {
+		return opt.New[float64]()
+	}
+
+	return opt.From(w.GetValue())
+}
+
+// DoubleValue returns a *wrapperspb.DoubleValue with the value of o, or nil if o is null
+func DoubleValue(o opt.Option[float64]) *wrapperspb.DoubleValue {
+	if o.IsNull() {
+		return nil
+	}
+
+	return wrapperspb.Double(o.V)
+}
+
+// FromFloatValue creates an Option[float32] that is null if w == nil, or non-null with w's value otherwise
+func FromFloatValue(w *wrapperspb.FloatValue) opt.Option[float32] {
+	if w == nil {
+		return opt.New[float32]()
+	}
+
+	return opt.From(w.GetValue())
+}
+
+// FloatValue returns a *wrapperspb.FloatValue with the value of o, or nil if o is null
+func FloatValue(o opt.Option[float32]) *wrapperspb.FloatValue {
+	if o.IsNull() {
+		return nil
+	}
+
+	return wrapperspb.Float(o.V)
+}
+
+// FromInt64Value creates an Option[int64] that is null if w == nil, or non-null with w's value otherwise
+func FromInt64Value(w *wrapperspb.Int64Value) opt.Option[int64] {
+	if w == nil {
+		return opt.New[int64]()
+	}
+
+	return opt.From(w.GetValue())
+}
+
+// Int64Value returns a *wrapperspb.Int64Value with the value of o, or nil if o is null
+func Int64Value(o opt.Option[int64]) *wrapperspb.Int64Value {
+	if o.IsNull() {
+		return nil
+	}
+
+	return wrapperspb.Int64(o.V)
+}
+
+// FromUInt64Value creates an Option[uint64] that is null if w == nil, or non-null with w's value otherwise
+func FromUInt64Value(w *wrapperspb.UInt64Value) opt.Option[uint64] {
+	if w == nil {
+		return opt.New[uint64]()
+	}
+
+	return opt.From(w.GetValue())
+}
+
+// UInt64Value returns a *wrapperspb.UInt64Value with the value of o, or nil if o is null
+func UInt64Value(o opt.Option[uint64]) *wrapperspb.UInt64Value {
+	if o.IsNull() {
+		return nil
+	}
+
+	return wrapperspb.UInt64(o.V)
+}
+
+// FromInt32Value creates an Option[int32] that is null if w == nil, or non-null with w's value otherwise
+func FromInt32Value(w *wrapperspb.Int32Value) opt.Option[int32] {
+	if w == nil {
+		return opt.New[int32]()
+	}
+
+	return opt.From(w.GetValue())
+}
+
+// Int32Value returns a *wrapperspb.Int32Value with the value of o, or nil if o is null
+func Int32Value(o opt.Option[int32]) *wrapperspb.Int32Value {
+	if o.IsNull() {
+		return nil
+	}
+
+	return wrapperspb.Int32(o.V)
+}
+
+// FromUInt32Value creates an Option[uint32] that is null if w == nil, or non-null with w's value otherwise
+func FromUInt32Value(w *wrapperspb.UInt32Value) opt.Option[uint32] {
+	if w == nil {
+		return opt.New[uint32]()
+	}
+
+	return opt.From(w.GetValue())
+}
+
+// UInt32Value returns a *wrapperspb.UInt32Value with the value of o, or nil if o is null
+func UInt32Value(o opt.Option[uint32]) *wrapperspb.UInt32Value {
+	if o.IsNull() {
+		return nil
+	}
+
+	return wrapperspb.UInt32(o.V)
+}
+
+// FromBoolValue creates an Option[bool] that is null if w == nil, or non-null with w's value otherwise
+func FromBoolValue(w *wrapperspb.BoolValue) opt.Option[bool] {
+	if w == nil {
+		return opt.New[bool]()
+	}
+
+	return opt.From(w.GetValue())
+}
+
+// BoolValue returns a *wrapperspb.BoolValue with the value of o, or nil if o is null
+func BoolValue(o opt.Option[bool]) *wrapperspb.BoolValue {
+	if o.IsNull() {
+		return nil
+	}
+
+	return wrapperspb.Bool(o.V)
+}
+
+// FromStringValue creates an Option[string] that is null if w == nil, or non-null with w's value otherwise
+func FromStringValue(w *wrapperspb.StringValue) opt.Option[string] {
+	if w == nil {
+		return opt.New[string]()
+	}
+
+	return opt.From(w.GetValue())
+}
+
+// StringValue returns a *wrapperspb.StringValue with the value of o, or nil if o is null
+func StringValue(o opt.Option[string]) *wrapperspb.StringValue {
+	if o.IsNull() {
+		return nil
+	}
+
+	return wrapperspb.String(o.V)
+}
+
+// FromBytesValue creates an Option[[]byte] that is null if w == nil, or non-null with w's value otherwise
+func FromBytesValue(w *wrapperspb.BytesValue) opt.Option[[]byte] {
+	if w == nil {
+		return opt.New[[]byte]()
+	}
+
+	return opt.From(w.GetValue())
+}
+
+// BytesValue returns a *wrapperspb.BytesValue with the value of o, or nil if o is null
+func BytesValue(o opt.Option[[]byte]) *wrapperspb.BytesValue {
+	if o.IsNull() {
+		return nil
+	}
+
+	return wrapperspb.Bytes(o.V)
+}
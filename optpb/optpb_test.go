@@ -0,0 +1,37 @@
+package optpb_test
+
+import (
+	"testing"
+
+	"github.com/FallenTaters/opt"
+	"github.com/FallenTaters/opt/optpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestInt64(t *testing.T) {
+	assertEq(t, optpb.FromInt64Value(nil), opt.New[int64]())
+	assertEq(t, optpb.FromInt64Value(wrapperspb.Int64(0)), opt.From(int64(0)))
+	assertEq(t, optpb.FromInt64Value(wrapperspb.Int64(-5)), opt.From(int64(-5)))
+
+	assertEq(t, optpb.Int64Value(opt.New[int64]()), nil)
+	assertEq(t, optpb.Int64Value(opt.From(int64(0))).GetValue(), 0)
+	assertEq(t, optpb.Int64Value(opt.From(int64(7))).GetValue(), 7)
+}
+
+func TestString(t *testing.T) {
+	assertEq(t, optpb.FromStringValue(nil), opt.New[string]())
+	assertEq(t, optpb.FromStringValue(wrapperspb.String("")), opt.From(""))
+	assertEq(t, optpb.FromStringValue(wrapperspb.String("hello")), opt.From("hello"))
+
+	assertEq(t, optpb.StringValue(opt.New[string]()), nil)
+	assertEq(t, optpb.StringValue(opt.From("")).GetValue(), "")
+	assertEq(t, optpb.StringValue(opt.From("hello")).GetValue(), "hello")
+}
+
+func assertEq[T comparable](t *testing.T, actual, expected T) {
+	t.Helper()
+
+	if actual != expected {
+		t.Errorf("expected %#v, got %#v", expected, actual)
+	}
+}
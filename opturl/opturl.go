@@ -0,0 +1,33 @@
+// Package opturl adds opt.Option values to url.Values, such as for building query strings.
+package opturl
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+
+	"github.com/FallenTaters/opt"
+)
+
+// Encode adds the value of o to v under key, or does nothing if o is null.
+// This way a null Option is omitted, instead of producing key=.
+// The value is formatted with MarshalText if T implements encoding.TextMarshaler, or fmt.Sprint otherwise.
+// An error is only returned by MarshalText, in which case v is left untouched.
+func Encode[T any](v url.Values, key string, o opt.Option[T]) error {
+	if o.IsNull() {
+		return nil
+	}
+
+	if m, ok := any(&o.V).(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		if err != nil {
+			return err
+		}
+
+		v.Add(key, string(text))
+		return nil
+	}
+
+	v.Add(key, fmt.Sprint(o.V))
+	return nil
+}
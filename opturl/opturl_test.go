@@ -0,0 +1,39 @@
+package opturl_test
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/FallenTaters/opt"
+	"github.com/FallenTaters/opt/opturl"
+)
+
+func assertEq[T comparable](t *testing.T, actual, expected T) {
+	t.Helper()
+
+	if actual != expected {
+		t.Errorf(`expected %v, got %v`, expected, actual)
+	}
+}
+
+type failingText struct{}
+
+func (failingText) MarshalText() ([]byte, error) {
+	return nil, errors.New("cannot marshal")
+}
+
+func TestEncode(t *testing.T) {
+	v := url.Values{}
+	assertEq(t, opturl.Encode(v, "a", opt.New[int]()), nil)
+	assertEq(t, opturl.Encode(v, "b", opt.From(0)), nil)
+	assertEq(t, opturl.Encode(v, "c", opt.From("x y")), nil)
+	assertEq(t, opturl.Encode(v, "d", opt.From(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))), nil)
+	assertEq(t, opturl.Encode(v, "e", opt.New[time.Time]()), nil)
+	assertEq(t, v.Encode(), "b=0&c=x+y&d=2020-01-02T03%3A04%3A05Z")
+
+	err := opturl.Encode(v, "f", opt.From(failingText{}))
+	assertEq(t, err.Error(), "cannot marshal")
+	assertEq(t, v.Has("f"), false)
+}
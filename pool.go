@@ -0,0 +1,37 @@
+package opt
+
+import (
+	"reflect"
+	"sync"
+)
+
+// pools holds a *sync.Pool of *Option[T] for each T used with GetPooled or PutPooled, keyed by reflect.Type
+var pools sync.Map
+
+func getPool[T any]() *sync.Pool {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if p, ok := pools.Load(t); ok {
+		return p.(*sync.Pool)
+	}
+
+	p, _ := pools.LoadOrStore(t, &sync.Pool{New: func() any { return new(Option[T]) }})
+	return p.(*sync.Pool)
+}
+
+// GetPooled returns a null *Option[T] from a pool, allocating one if the pool is empty.
+// Return it with PutPooled once it is no longer used, to reduce allocations in hot paths.
+func GetPooled[T any]() *Option[T] {
+	return getPool[T]().Get().(*Option[T])
+}
+
+// PutPooled resets o to null and returns it to the pool used by GetPooled.
+// o is zeroed, so the pool does not keep its value alive. o must not be used afterwards.
+// A nil o is ignored.
+func PutPooled[T any](o *Option[T]) {
+	if o == nil {
+		return
+	}
+
+	*o = Option[T]{}
+	getPool[T]().Put(o)
+}
@@ -0,0 +1,48 @@
+package opt_test
+
+import (
+	"testing"
+
+	"github.com/FallenTaters/opt"
+)
+
+func TestPooled(t *testing.T) {
+	o := opt.GetPooled[[]int]()
+	assertEq(t, o.IsNull(), true)
+	assertEq(t, o.V == nil, true)
+
+	*o = opt.From([]int{1, 2, 3})
+	opt.PutPooled(o)
+	assertEq(t, o.IsNull(), true)
+	assertEq(t, o.V == nil, true)
+
+	for i := 0; i < 10; i++ {
+		o := opt.GetPooled[[]int]()
+		assertEq(t, o.IsNull(), true)
+		assertEq(t, o.V == nil, true)
+		opt.PutPooled(o)
+	}
+
+	s := opt.GetPooled[string]()
+	assertEq(t, *s, opt.New[string]())
+	opt.PutPooled(s)
+
+	opt.PutPooled[int](nil)
+}
+
+var sink *opt.Option[[]byte]
+
+func BenchmarkNew(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sink = new(opt.Option[[]byte])
+	}
+}
+
+func BenchmarkPooled(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sink = opt.GetPooled[[]byte]()
+		opt.PutPooled(sink)
+	}
+}
@@ -0,0 +1,19 @@
+package opt
+
+import "regexp"
+
+// FromMatch creates an Option[string] with the text of capture group group in the first match of re in s,
+// where group 0 is the entire match. It is null if re does not match s, group is out of range,
+// or the group did not participate in the match, such as an unmatched optional group.
+func FromMatch(re *regexp.Regexp, s string, group int) Option[string] {
+	if group < 0 || group > re.NumSubexp() {
+		return New[string]()
+	}
+
+	loc := re.FindStringSubmatchIndex(s)
+	if loc == nil || loc[2*group] < 0 {
+		return New[string]()
+	}
+
+	return From(s[loc[2*group]:loc[2*group+1]])
+}
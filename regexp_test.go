@@ -0,0 +1,21 @@
+package opt_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/FallenTaters/opt"
+)
+
+func TestFromMatch(t *testing.T) {
+	re := regexp.MustCompile(`id=(\d*)(x)?`)
+
+	assertEq(t, opt.FromMatch(re, "user id=42;", 0), opt.From("id=42"))
+	assertEq(t, opt.FromMatch(re, "user id=42;", 1), opt.From("42"))
+	assertEq(t, opt.FromMatch(re, "user id=;", 1), opt.From(""))
+	assertEq(t, opt.FromMatch(re, "user id=42;", 2), opt.New[string]())
+	assertEq(t, opt.FromMatch(re, "user id=42x", 2), opt.From("x"))
+	assertEq(t, opt.FromMatch(re, "user name=bob", 1), opt.New[string]())
+	assertEq(t, opt.FromMatch(re, "user id=42;", 3), opt.New[string]())
+	assertEq(t, opt.FromMatch(re, "user id=42;", -1), opt.New[string]())
+}
@@ -0,0 +1,38 @@
+package opt
+
+import "errors"
+
+// Result holds either a value or an error
+type Result[T any] struct {
+	V   T
+	Err error
+}
+
+// Ok creates a successful Result[T] with v
+func Ok[T any](v T) Result[T] {
+	return Result[T]{V: v}
+}
+
+// Err creates a failed Result[T] with err
+func Err[T any](err error) Result[T] {
+	return Result[T]{Err: err}
+}
+
+// CollectErrors returns the values of all successful results,
+// and all errors of failed results joined by errors.Join.
+// If no result failed, the error is nil.
+func CollectErrors[T any](results []Result[T]) ([]T, error) {
+	vs := make([]T, 0, len(results))
+	var errs []error
+
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, r.Err)
+			continue
+		}
+
+		vs = append(vs, r.V)
+	}
+
+	return vs, errors.Join(errs...)
+}
@@ -0,0 +1,29 @@
+package opt_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/FallenTaters/opt"
+)
+
+func TestCollectErrors(t *testing.T) {
+	err1 := errors.New("one")
+	err2 := errors.New("two")
+
+	vs, err := opt.CollectErrors([]opt.Result[int]{opt.Ok(1), opt.Err[int](err1), opt.Ok(3), opt.Err[int](err2)})
+	assertEq(t, len(vs), 2)
+	assertEq(t, vs[0], 1)
+	assertEq(t, vs[1], 3)
+	assertErrorEq(t, err, errors.New("one\ntwo"))
+	assertEq(t, errors.Is(err, err1), true)
+	assertEq(t, errors.Is(err, err2), true)
+
+	vs, err = opt.CollectErrors([]opt.Result[int]{opt.Ok(1), opt.Ok(2)})
+	assertEq(t, len(vs), 2)
+	assertErrorEq(t, err, nil)
+
+	vs, err = opt.CollectErrors[int](nil)
+	assertEq(t, len(vs), 0)
+	assertErrorEq(t, err, nil)
+}
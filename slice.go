@@ -0,0 +1,126 @@
+package opt
+
+import (
+	"fmt"
+	"sort"
+)
+
+var _ sort.Interface = Options[int]{}
+
+// CollectAtLeast returns the values of all non-null options in in,
+// if at least min of them are non-null.
+// Otherwise it returns a null Option and an error.
+func CollectAtLeast[T any](in []Option[T], min int) (Option[[]T], error) {
+	vs := make([]T, 0, len(in))
+	for _, o := range in {
+		if o.Valid {
+			vs = append(vs, o.V)
+		}
+	}
+
+	if len(vs) < min {
+		return New[[]T](), fmt.Errorf("opt: %d of %d options are non-null, need at least %d", len(vs), len(in), min)
+	}
+
+	return From(vs), nil
+}
+
+// Index returns the element at index i of the slice contained by o.
+// It returns null if o is null or i is out of range.
+func Index[T any](o Option[[]T], i int) Option[T] {
+	if !o.Valid || i < 0 || i >= len(o.V) {
+		return New[T]()
+	}
+
+	return From(o.V[i])
+}
+
+// Collect returns a non-null Option with the values of all options in opts,
+// or null if any of them is null.
+func Collect[T any](opts []Option[T]) Option[[]T] {
+	vs := make([]T, 0, len(opts))
+	for _, o := range opts {
+		if !o.Valid {
+			return New[[]T]()
+		}
+
+		vs = append(vs, o.V)
+	}
+
+	return From(vs)
+}
+
+// CountPresent returns the number of non-null options in in
+func CountPresent[T any](in []Option[T]) int {
+	n := 0
+	for _, o := range in {
+		if o.Valid {
+			n++
+		}
+	}
+
+	return n
+}
+
+// DefaultAll returns the values of all options in opts, in order,
+// with def in place of each null option.
+func DefaultAll[T any](opts []Option[T], def T) []T {
+	vs := make([]T, len(opts))
+	for i, o := range opts {
+		if o.Valid {
+			vs[i] = o.V
+		} else {
+			vs[i] = def
+		}
+	}
+
+	return vs
+}
+
+// Ordered is a constraint for types supporting the < operator.
+// It matches cmp.Ordered, which is not available in the Go version this module supports.
+type Ordered interface {
+	Integer | ~float32 | ~float64 | ~string
+}
+
+// Options is a slice of options that implements sort.Interface.
+// Null options are ordered before all non-null options.
+type Options[T Ordered] []Option[T]
+
+// Len implements sort.Interface
+func (s Options[T]) Len() int {
+	return len(s)
+}
+
+// Less implements sort.Interface
+func (s Options[T]) Less(i, j int) bool {
+	if !s[i].Valid || !s[j].Valid {
+		return !s[i].Valid && s[j].Valid
+	}
+
+	return s[i].V < s[j].V
+}
+
+// Swap implements sort.Interface
+func (s Options[T]) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// FillNull returns a slice with, for each index, the option in primary if it is non-null,
+// or the option in fallback otherwise. It returns an error if the slices differ in length.
+func FillNull[T any](primary, fallback []Option[T]) ([]Option[T], error) {
+	if len(primary) != len(fallback) {
+		return nil, fmt.Errorf("opt: cannot fill %d options from %d options", len(primary), len(fallback))
+	}
+
+	out := make([]Option[T], len(primary))
+	for i, o := range primary {
+		if o.Valid {
+			out[i] = o
+		} else {
+			out[i] = fallback[i]
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,103 @@
+package opt_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/FallenTaters/opt"
+)
+
+func TestCollectAtLeast(t *testing.T) {
+	in := []opt.Option[int]{opt.From(1), opt.New[int](), opt.From(3)}
+
+	for _, min := range []int{0, 1, 2} {
+		o, err := opt.CollectAtLeast(in, min)
+		assertErrorEq(t, err, nil)
+		assertEq(t, o.Valid, true)
+		assertEq(t, len(o.V), 2)
+		assertEq(t, o.V[0], 1)
+		assertEq(t, o.V[1], 3)
+	}
+
+	o, err := opt.CollectAtLeast(in, 3)
+	assertErrorEq(t, err, errors.New("opt: 2 of 3 options are non-null, need at least 3"))
+	assertEq(t, o.IsNull(), true)
+}
+
+func TestIndex(t *testing.T) {
+	o := opt.From([]string{"a", "b"})
+
+	assertEq(t, opt.Index(o, 0), opt.From("a"))
+	assertEq(t, opt.Index(o, 1), opt.From("b"))
+	assertEq(t, opt.Index(o, 2), opt.New[string]())
+	assertEq(t, opt.Index(o, -1), opt.New[string]())
+	assertEq(t, opt.Index(opt.New[[]string](), 0), opt.New[string]())
+	assertEq(t, opt.Index(opt.From([]string(nil)), 0), opt.New[string]())
+}
+
+func TestCollect(t *testing.T) {
+	o := opt.Collect([]opt.Option[int]{opt.From(1), opt.From(0), opt.From(3)})
+	assertEq(t, o.Valid, true)
+	assertEq(t, len(o.V), 3)
+	assertEq(t, o.V[0], 1)
+	assertEq(t, o.V[1], 0)
+	assertEq(t, o.V[2], 3)
+
+	o = opt.Collect([]opt.Option[int]{opt.From(1), opt.New[int](), opt.From(3)})
+	assertEq(t, o.IsNull(), true)
+
+	o = opt.Collect[int](nil)
+	assertEq(t, o.Valid, true)
+	assertEq(t, len(o.V), 0)
+}
+
+func TestCountPresent(t *testing.T) {
+	assertEq(t, opt.CountPresent([]opt.Option[int]{opt.From(1), opt.New[int](), opt.From(0)}), 2)
+	assertEq(t, opt.CountPresent([]opt.Option[int]{opt.New[int](), opt.New[int]()}), 0)
+	assertEq(t, opt.CountPresent[int](nil), 0)
+}
+
+func TestDefaultAll(t *testing.T) {
+	vs := opt.DefaultAll([]opt.Option[int]{opt.From(1), opt.New[int](), opt.From(0), opt.New[int]()}, -1)
+	assertEq(t, len(vs), 4)
+	assertEq(t, vs[0], 1)
+	assertEq(t, vs[1], -1)
+	assertEq(t, vs[2], 0)
+	assertEq(t, vs[3], -1)
+
+	assertEq(t, len(opt.DefaultAll[int](nil, 1)), 0)
+}
+
+func TestOptions(t *testing.T) {
+	s := opt.Options[int]{opt.From(3), opt.New[int](), opt.From(-1), opt.From(2), opt.New[int]()}
+	sort.Sort(s)
+
+	expected := opt.Options[int]{opt.New[int](), opt.New[int](), opt.From(-1), opt.From(2), opt.From(3)}
+	for i := range expected {
+		assertEq(t, s[i], expected[i])
+	}
+
+	strs := opt.Options[string]{opt.From("b"), opt.From("a"), opt.New[string]()}
+	sort.Sort(sort.Reverse(strs))
+	assertEq(t, strs[0], opt.From("b"))
+	assertEq(t, strs[1], opt.From("a"))
+	assertEq(t, strs[2], opt.New[string]())
+}
+
+func TestFillNull(t *testing.T) {
+	out, err := opt.FillNull(
+		[]opt.Option[int]{opt.From(1), opt.New[int](), opt.From(3), opt.New[int]()},
+		[]opt.Option[int]{opt.From(10), opt.From(20), opt.New[int](), opt.New[int]()},
+	)
+	assertErrorEq(t, err, nil)
+	assertEq(t, len(out), 4)
+	assertEq(t, out[0], opt.From(1))
+	assertEq(t, out[1], opt.From(20))
+	assertEq(t, out[2], opt.From(3))
+	assertEq(t, out[3], opt.New[int]())
+
+	out, err = opt.FillNull([]opt.Option[int]{opt.From(1)}, nil)
+	assertErrorEq(t, err, errors.New("opt: cannot fill 1 options from 0 options"))
+	assertEq(t, out == nil, true)
+}
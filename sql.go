@@ -0,0 +1,644 @@
+package opt
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	_ driver.Valuer = TracedOption[struct{}]{}
+	_ sql.Scanner   = &TracedOption[struct{}]{}
+	_ driver.Valuer = EpochTimeOption{}
+	_ sql.Scanner   = &EpochTimeOption{}
+	_ sql.Scanner   = &LocaleOption[float64]{}
+	_ sql.Scanner   = &PGArrayOption[int]{}
+	_ sql.Scanner   = &DefaultOption[int]{}
+	_ sql.Scanner   = &TrimOption[string]{}
+	_ sql.Scanner   = &BoolAsNumber[int]{}
+	_ sql.Scanner   = &RuneOption{}
+	_ driver.Valuer = HStoreOption{}
+	_ sql.Scanner   = &HStoreOption{}
+)
+
+// NamedArg returns a sql.NamedArg with the given name, whose value is Option itself.
+// This way the driver values it using Value, so a null Option becomes a NULL parameter.
+func (o Option[T]) NamedArg(name string) sql.NamedArg {
+	return sql.Named(name, o)
+}
+
+// AppendSet sets m[column] to o.V if o is non-null, and leaves m untouched otherwise.
+// This is useful for building a dynamic UPDATE that only sets the provided fields.
+func (o Option[T]) AppendSet(m map[string]any, column string) {
+	if o.Valid {
+		m[column] = o.V
+	}
+}
+
+// ErrNull is returned by ScanRequired when the source is NULL
+var ErrNull = errors.New("opt: converting NULL into a required value")
+
+// ScanRequired is like Scan, but returns ErrNull if src is NULL.
+// This enforces NOT NULL in the application, rather than producing a null Option.
+func (o *Option[T]) ScanRequired(src any) error {
+	if src == nil {
+		*o = New[T]()
+		return ErrNull
+	}
+
+	return o.Scan(src)
+}
+
+// ScanInto is like Scan, but if T is []byte, a []byte or string source is copied into the storage of buf,
+// instead of a newly allocated slice. If buf lacks the capacity, append allocates as usual.
+// Passing the previous value, as in o.ScanInto(o.V, src), avoids an allocation per row in scan loops.
+// For other types T, buf is ignored.
+func (o *Option[T]) ScanInto(buf []byte, src any) error {
+	d, ok := any(&o.V).(*[]byte)
+	if !ok {
+		return o.Scan(src)
+	}
+
+	switch s := src.(type) {
+	case []byte:
+		// like Scan, a nil source stays nil, while an empty one is non-nil even if buf is nil
+		if s == nil {
+			*d = nil
+		} else {
+			*d = append(buf[:0], s...)
+			if *d == nil {
+				*d = []byte{}
+			}
+		}
+	case string:
+		*d = append(buf[:0], s...)
+		if *d == nil {
+			*d = []byte{}
+		}
+	default:
+		return o.Scan(src)
+	}
+
+	o.Valid = true
+	return nil
+}
+
+// parsers holds the functions registered by RegisterParser, keyed by reflect.Type
+var parsers sync.Map
+
+// RegisterParser registers parse to be used when scanning a string or []byte into a T.
+// This allows scanning into types that only offer a constructor from a string,
+// such as decimal types. Registering a parser for a type replaces the previous one.
+// It also applies to Parse, flags and environment variables, since those scan a string.
+func RegisterParser[T any](parse func(string) (T, error)) {
+	parsers.Store(reflect.TypeOf((*T)(nil)).Elem(), func(dest any, s string) error {
+		v, err := parse(s)
+		if err != nil {
+			return err
+		}
+
+		*dest.(*T) = v
+		return nil
+	})
+}
+
+// UnregisterParser removes the parser registered for T with RegisterParser, if any
+func UnregisterParser[T any]() {
+	parsers.Delete(reflect.TypeOf((*T)(nil)).Elem())
+}
+
+// scanParser scans src into dest using a parser registered with RegisterParser.
+// It returns false if src is not a string or []byte, or no parser is registered for the type of dest.
+// []byte sources are only converted to a string once a parser is found, to avoid allocating otherwise.
+func scanParser(dest, src any) (bool, error) {
+	switch src.(type) {
+	case string, []byte:
+	default:
+		return false, nil
+	}
+
+	parse, ok := parsers.Load(reflect.TypeOf(dest).Elem())
+	if !ok {
+		return false, nil
+	}
+
+	return true, parse.(func(any, string) error)(dest, asString(src))
+}
+
+var (
+	stringType = reflect.TypeOf("")
+	intType    = reflect.TypeOf(0)
+	boolType   = reflect.TypeOf(false)
+	errorType  = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// scanSetString scans a string or numeric src into dest using its SetString method,
+// as found on decimal types such as *big.Int, *big.Rat and *big.Float.
+// SetString must take a string, optionally followed by an int base, for which 10 is passed.
+// Its last result must be an error, or a bool reporting success.
+// It returns false if src is not a string, []byte or number, or dest has no such method.
+func scanSetString(dest, src any) (bool, error) {
+	switch reflect.ValueOf(src).Kind() {
+	case reflect.Slice:
+		if _, ok := src.([]byte); !ok {
+			return false, nil
+		}
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+	default:
+		return false, nil
+	}
+
+	m := reflect.ValueOf(dest).MethodByName("SetString")
+	if !m.IsValid() {
+		return false, nil
+	}
+
+	mt := m.Type()
+	if mt.NumIn() < 1 || mt.NumIn() > 2 || mt.In(0) != stringType || (mt.NumIn() == 2 && mt.In(1) != intType) ||
+		mt.NumOut() == 0 || (mt.Out(mt.NumOut()-1) != errorType && mt.Out(mt.NumOut()-1) != boolType) {
+		return false, nil
+	}
+
+	s := asString(src)
+	args := []reflect.Value{reflect.ValueOf(s)}
+	if mt.NumIn() == 2 {
+		args = append(args, reflect.ValueOf(10))
+	}
+
+	out := m.Call(args)
+	switch last := out[len(out)-1].Interface().(type) {
+	case bool:
+		if !last {
+			return true, fmt.Errorf("converting driver.Value type %T (%q) to a %s: invalid syntax",
+				src, s, getTypeName(reflect.TypeOf(dest).Elem()))
+		}
+	case error:
+		return true, last
+	}
+
+	return true, nil
+}
+
+// TracedOption is an Option[T] that records the type of the driver value it was last scanned from.
+// Scanning behaves exactly like Option[T]. It is meant to help diagnose unexpected scan results.
+type TracedOption[T any] struct {
+	Option[T]
+
+	// SourceType is the type of the last scanned driver value, or nil if it was NULL
+	SourceType reflect.Type
+}
+
+// Scan implements sql.Scanner
+func (o *TracedOption[T]) Scan(data any) error {
+	o.SourceType = reflect.TypeOf(data)
+
+	return o.Option.Scan(data)
+}
+
+// EpochTimeOption is an Option[time.Time] that is stored in the database as an integer Unix epoch.
+// Unit is the duration of one epoch tick, such as time.Second or time.Millisecond.
+// A zero Unit is interpreted as time.Second.
+// Sources other than int64 are scanned like Option[time.Time].
+type EpochTimeOption struct {
+	Option[time.Time]
+
+	Unit time.Duration
+}
+
+func (o EpochTimeOption) unit() time.Duration {
+	if o.Unit <= 0 {
+		return time.Second
+	}
+
+	return o.Unit
+}
+
+// Value implements driver.Valuer.
+// Times that are not a whole number of units are rounded down, like time.Time.Unix.
+func (o EpochTimeOption) Value() (driver.Value, error) {
+	if !o.Valid {
+		return nil, nil
+	}
+
+	// the arithmetic is done in nanoseconds using big.Int, since units such as 1500ms are not whole seconds,
+	// and Unix nanoseconds only fit an int64 for years 1678 to 2262
+	ns := new(big.Int).Mul(big.NewInt(o.V.Unix()), big.NewInt(int64(time.Second)))
+	ns.Add(ns, big.NewInt(int64(o.V.Nanosecond())))
+
+	epoch := ns.Div(ns, big.NewInt(int64(o.unit())))
+	if !epoch.IsInt64() {
+		return nil, fmt.Errorf("opt: %s in units of %s overflows int64", o.V, o.unit())
+	}
+
+	return epoch.Int64(), nil
+}
+
+// Scan implements sql.Scanner
+func (o *EpochTimeOption) Scan(data any) error {
+	v, ok := data.(int64)
+	if !ok {
+		return o.Option.Scan(data)
+	}
+
+	o.Option = New[time.Time]()
+
+	ns := new(big.Int).Mul(big.NewInt(v), big.NewInt(int64(o.unit())))
+	sec, nsec := new(big.Int).DivMod(ns, big.NewInt(int64(time.Second)), new(big.Int))
+	if !sec.IsInt64() {
+		return fmt.Errorf("opt: epoch %d in units of %s overflows time.Time", v, o.unit())
+	}
+
+	o.Option = From(time.Unix(sec.Int64(), nsec.Int64()))
+	return nil
+}
+
+// LocaleOption is an Option[T] that scans numbers from strings using alternate separators, such as "1.234,56".
+// String and []byte sources have every ThousandsSep removed and every DecimalSep replaced by '.' before scanning.
+// A zero separator is not applied, so a zero LocaleOption scans exactly like Option[T].
+type LocaleOption[T any] struct {
+	Option[T]
+
+	ThousandsSep rune
+	DecimalSep   rune
+}
+
+// Scan implements sql.Scanner
+func (o *LocaleOption[T]) Scan(data any) error {
+	switch v := data.(type) {
+	case string:
+		return o.Option.Scan(o.normalize(v))
+	case []byte:
+		return o.Option.Scan(o.normalize(string(v)))
+	}
+
+	return o.Option.Scan(data)
+}
+
+func (o LocaleOption[T]) normalize(s string) string {
+	if o.ThousandsSep != 0 {
+		s = strings.ReplaceAll(s, string(o.ThousandsSep), "")
+	}
+
+	if o.DecimalSep != 0 {
+		s = strings.ReplaceAll(s, string(o.DecimalSep), ".")
+	}
+
+	return s
+}
+
+// DefaultOption is an Option[T] that scans NULL as Default instead of null.
+// Other sources are scanned like Option[T].
+type DefaultOption[T any] struct {
+	Option[T]
+
+	Default T
+}
+
+// Scan implements sql.Scanner
+func (o *DefaultOption[T]) Scan(data any) error {
+	if data == nil {
+		o.Option = From(o.Default)
+		return nil
+	}
+
+	return o.Option.Scan(data)
+}
+
+// TrimOption is an Option[T] that trims surrounding whitespace from string and []byte sources,
+// such as padded CHAR columns, when T is a string type.
+// For other types T, it scans exactly like Option[T].
+type TrimOption[T any] struct {
+	Option[T]
+}
+
+// Scan implements sql.Scanner
+func (o *TrimOption[T]) Scan(data any) error {
+	if reflect.TypeOf((*T)(nil)).Elem().Kind() != reflect.String {
+		return o.Option.Scan(data)
+	}
+
+	switch v := data.(type) {
+	case string:
+		return o.Option.Scan(strings.TrimSpace(v))
+	case []byte:
+		return o.Option.Scan(strings.TrimSpace(string(v)))
+	}
+
+	return o.Option.Scan(data)
+}
+
+// BoolAsNumber is an Option[T] that scans the bool sources true and false as 1 and 0,
+// as some drivers return for numeric columns. Option[T] itself rejects bool sources for numeric types.
+// Other sources are scanned like Option[T].
+type BoolAsNumber[T any] struct {
+	Option[T]
+}
+
+// Scan implements sql.Scanner
+func (o *BoolAsNumber[T]) Scan(data any) error {
+	if b, ok := data.(bool); ok {
+		switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			if b {
+				return o.Option.Scan(int64(1))
+			}
+
+			return o.Option.Scan(int64(0))
+		}
+	}
+
+	return o.Option.Scan(data)
+}
+
+// RuneOption is an Option[rune] that scans a string or []byte source of exactly one character as its code point,
+// such as "A" as 'A' and "5" as '5'. Other strings, including empty ones, are an error.
+// Other sources are scanned like Option[rune], which parses strings as integers instead.
+type RuneOption struct {
+	Option[rune]
+}
+
+// Scan implements sql.Scanner
+func (o *RuneOption) Scan(data any) error {
+	var s string
+	switch v := data.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return o.Option.Scan(data)
+	}
+
+	o.Option = New[rune]()
+
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 || size != len(s) || (r == utf8.RuneError && size == 1) {
+		return fmt.Errorf("converting driver.Value type %T (%q) to a rune: not a single character", data, s)
+	}
+
+	o.Option = From(r)
+	return nil
+}
+
+// PGArrayOption is an Option[[]T] that is scanned from a Postgres array literal, such as {1,2,3}.
+// Elements are converted in the same way as Scan does for a string.
+// Nested arrays and NULL elements are not supported.
+type PGArrayOption[T any] struct {
+	Option[[]T]
+}
+
+// Scan implements sql.Scanner
+func (o *PGArrayOption[T]) Scan(data any) error {
+	o.Option = New[[]T]()
+
+	var s string
+	switch v := data.(type) {
+	case nil:
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("unsupported Scan, storing driver.Value type %T into a Postgres array", data)
+	}
+
+	elems, err := parsePGArray(s)
+	if err != nil {
+		return err
+	}
+
+	vs := make([]T, len(elems))
+	for i, elem := range elems {
+		if err := scanAssign(&vs[i], elem); err != nil {
+			return err
+		}
+	}
+
+	o.Option = From(vs)
+	return nil
+}
+
+// parsePGArray splits a one-dimensional Postgres array literal into its unquoted elements
+func parsePGArray(s string) ([]string, error) {
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("invalid Postgres array %q", s)
+	}
+
+	body := s[1 : len(s)-1]
+	elems := []string{}
+	if strings.TrimSpace(body) == "" {
+		return elems, nil
+	}
+
+	for i := 0; i <= len(body); i++ {
+		for i < len(body) && body[i] == ' ' {
+			i++
+		}
+
+		var elem strings.Builder
+		if i < len(body) && body[i] == '"' {
+			for i++; i < len(body) && body[i] != '"'; i++ {
+				if body[i] == '\\' && i+1 < len(body) {
+					i++
+				}
+				elem.WriteByte(body[i])
+			}
+			if i >= len(body) {
+				return nil, fmt.Errorf("invalid Postgres array %q: unterminated quote", s)
+			}
+			i++
+			for i < len(body) && body[i] == ' ' {
+				i++
+			}
+		} else {
+			start := i
+			for i < len(body) && body[i] != ',' {
+				if body[i] == '{' || body[i] == '"' {
+					return nil, fmt.Errorf("invalid Postgres array %q: nested arrays are unsupported", s)
+				}
+				i++
+			}
+
+			raw := strings.TrimSpace(body[start:i])
+			if raw == "" {
+				if i == len(body) {
+					return nil, fmt.Errorf("invalid Postgres array %q: trailing comma", s)
+				}
+				return nil, fmt.Errorf("invalid Postgres array %q: empty element", s)
+			}
+			if strings.EqualFold(raw, "NULL") {
+				return nil, errors.New("converting NULL element of Postgres array is unsupported")
+			}
+			elem.WriteString(raw)
+		}
+
+		if i < len(body) && body[i] != ',' {
+			return nil, fmt.Errorf("invalid Postgres array %q", s)
+		}
+
+		elems = append(elems, elem.String())
+	}
+
+	return elems, nil
+}
+
+// HStoreOption is an Option[map[string]string] that is stored in the database as Postgres hstore text,
+// such as "a"=>"1", "b"=>"2". An empty string scans as an empty map.
+// NULL values inside the hstore are not supported.
+type HStoreOption struct {
+	Option[map[string]string]
+}
+
+// Value implements driver.Valuer.
+// Pairs are sorted by key, so the same map always produces the same text.
+func (o HStoreOption) Value() (driver.Value, error) {
+	if !o.Valid {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(o.V))
+	for k := range o.V {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+
+		writeHStoreString(&b, k)
+		b.WriteString("=>")
+		writeHStoreString(&b, o.V[k])
+	}
+
+	return b.String(), nil
+}
+
+func writeHStoreString(b *strings.Builder, s string) {
+	b.WriteByte('"')
+	for _, r := range s {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+}
+
+// Scan implements sql.Scanner
+func (o *HStoreOption) Scan(data any) error {
+	o.Option = New[map[string]string]()
+
+	var s string
+	switch v := data.(type) {
+	case nil:
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("unsupported Scan, storing driver.Value type %T into an hstore", data)
+	}
+
+	m, err := parseHStore(s)
+	if err != nil {
+		return err
+	}
+
+	o.Option = From(m)
+	return nil
+}
+
+// parseHStore parses Postgres hstore text into a map
+func parseHStore(s string) (map[string]string, error) {
+	m := map[string]string{}
+
+	rest := strings.TrimSpace(s)
+	for rest != "" {
+		key, quoted, tail, err := readHStoreToken(rest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hstore %q: %w", s, err)
+		}
+
+		tail = strings.TrimSpace(tail)
+		if !quoted && strings.EqualFold(key, "NULL") {
+			return nil, fmt.Errorf("invalid hstore %q: NULL key", s)
+		}
+		if !strings.HasPrefix(tail, "=>") {
+			return nil, fmt.Errorf("invalid hstore %q: expected =>", s)
+		}
+
+		value, quoted, tail, err := readHStoreToken(strings.TrimSpace(tail[2:]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid hstore %q: %w", s, err)
+		}
+		if !quoted && strings.EqualFold(value, "NULL") {
+			return nil, errors.New("converting NULL value of hstore is unsupported")
+		}
+
+		m[key] = value
+
+		rest = strings.TrimSpace(tail)
+		if rest == "" {
+			break
+		}
+		if rest[0] != ',' {
+			return nil, fmt.Errorf("invalid hstore %q: expected ,", s)
+		}
+		rest = strings.TrimSpace(rest[1:])
+		if rest == "" {
+			return nil, fmt.Errorf("invalid hstore %q: trailing ,", s)
+		}
+	}
+
+	return m, nil
+}
+
+// readHStoreToken reads a quoted or unquoted key or value from the start of s, and returns the remainder
+func readHStoreToken(s string) (token string, quoted bool, rest string, err error) {
+	if s == "" {
+		return "", false, "", errors.New("unexpected end")
+	}
+
+	if s[0] != '"' {
+		end := strings.IndexAny(s, ",= ")
+		if end < 0 {
+			end = len(s)
+		}
+		if end == 0 {
+			return "", false, "", fmt.Errorf("unexpected %q", s[0])
+		}
+
+		return s[:end], false, s[end:], nil
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+		case s[i] == '"':
+			return b.String(), true, s[i+1:], nil
+		}
+		b.WriteByte(s[i])
+	}
+
+	return "", false, "", errors.New("unterminated quote")
+}
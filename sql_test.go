@@ -0,0 +1,676 @@
+package opt_test
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FallenTaters/opt"
+)
+
+func TestNamedArg(t *testing.T) {
+	for _, o := range []opt.Option[int64]{opt.New[int64](), opt.From(int64(0)), opt.From(int64(5))} {
+		arg := o.NamedArg("p")
+		assertEq(t, arg.Name, "p")
+
+		valuer, ok := arg.Value.(driver.Valuer)
+		assertEq(t, ok, true)
+
+		argVal, argErr := valuer.Value()
+		optVal, optErr := o.Value()
+		assertErrorEq(t, argErr, optErr)
+		assertEq(t, argVal, optVal)
+	}
+}
+
+func TestAppendSet(t *testing.T) {
+	m := map[string]any{}
+	opt.New[string]().AppendSet(m, "name")
+	assertEq(t, len(m), 0)
+
+	opt.From("").AppendSet(m, "name")
+	opt.From(3).AppendSet(m, "age")
+	assertEq(t, len(m), 2)
+	assertEq(t, m["name"], any(""))
+	assertEq(t, m["age"], any(3))
+}
+
+func TestScanRequired(t *testing.T) {
+	o := opt.From(1)
+	assertEq(t, o.ScanRequired(nil), opt.ErrNull)
+	assertEq(t, o, opt.New[int]())
+
+	assertErrorEq(t, o.ScanRequired(int64(2)), nil)
+	assertEq(t, o, opt.From(2))
+
+	assertEq(t, o.ScanRequired("abc") != nil, true)
+}
+
+func TestScanInto(t *testing.T) {
+	buf := make([]byte, 0, 16)
+
+	var o opt.Option[[]byte]
+	if err := o.ScanInto(buf, []byte("hello")); err != nil {
+		t.Error(err)
+	}
+	assertBytesEq(t, o.V, []byte("hello"))
+	assertEq(t, &o.V[0], &buf[:1][0])
+
+	if err := o.ScanInto(o.V, "bye"); err != nil {
+		t.Error(err)
+	}
+	assertBytesEq(t, o.V, []byte("bye"))
+	assertEq(t, &o.V[0], &buf[:1][0])
+
+	if err := o.ScanInto(o.V, nil); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o.IsNull(), true)
+
+	if err := o.ScanInto(nil, int64(12)); err != nil {
+		t.Error(err)
+	}
+	assertBytesEq(t, o.V, []byte("12"))
+
+	for _, src := range []any{[]byte{}, ""} {
+		if err := o.ScanInto(nil, src); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Valid, true)
+		assertEq(t, o.V != nil, true)
+		assertEq(t, len(o.V), 0)
+	}
+
+	var scanned opt.Option[[]byte]
+	assertErrorEq(t, scanned.Scan([]byte(nil)), nil)
+	assertErrorEq(t, o.ScanInto(buf, []byte(nil)), nil)
+	assertEq(t, o.Valid, scanned.Valid)
+	assertEq(t, o.V == nil, scanned.V == nil)
+	assertEq(t, o.V == nil, true)
+
+	var i opt.Option[int]
+	if err := i.ScanInto(buf, "3"); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, i, opt.From(3))
+}
+
+func BenchmarkScan(b *testing.B) {
+	src := []byte("some column value")
+	var o opt.Option[[]byte]
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = o.Scan(src)
+	}
+}
+
+func BenchmarkScanInto(b *testing.B) {
+	src := []byte("some column value")
+	var o opt.Option[[]byte]
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = o.ScanInto(o.V, src)
+	}
+}
+
+func TestTracedOption(t *testing.T) {
+	var o opt.TracedOption[int]
+	if err := o.Scan(int64(1)); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o.Option, opt.From(1))
+	assertEq(t, o.SourceType, reflect.TypeOf(int64(0)))
+
+	if err := o.Scan("2"); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o.Option, opt.From(2))
+	assertEq(t, o.SourceType, reflect.TypeOf(""))
+
+	if err := o.Scan(nil); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o.Option, opt.New[int]())
+	assertEq(t, o.SourceType, nil)
+}
+
+func TestEpochTimeOption(t *testing.T) {
+	t.Run("seconds", func(t *testing.T) {
+		var o opt.EpochTimeOption
+		if err := o.Scan(int64(1700000000)); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Valid, true)
+		assertEq(t, o.V.Equal(time.Unix(1700000000, 0)), true)
+
+		v, err := o.Value()
+		assertErrorEq(t, err, nil)
+		assertEq[any](t, v, int64(1700000000))
+	})
+
+	t.Run("milliseconds", func(t *testing.T) {
+		o := opt.EpochTimeOption{Unit: time.Millisecond}
+		if err := o.Scan(int64(1700000000123)); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Valid, true)
+		assertEq(t, o.V.Equal(time.UnixMilli(1700000000123)), true)
+
+		v, err := o.Value()
+		assertErrorEq(t, err, nil)
+		assertEq[any](t, v, int64(1700000000123))
+	})
+
+	t.Run("non-integral seconds", func(t *testing.T) {
+		o := opt.EpochTimeOption{Unit: 1500 * time.Millisecond}
+		if err := o.Scan(int64(2)); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.V.Equal(time.Unix(3, 0)), true)
+
+		v, err := o.Value()
+		assertErrorEq(t, err, nil)
+		assertEq[any](t, v, int64(2))
+
+		o.Option = opt.From(time.Unix(4, 0))
+		v, err = o.Value()
+		assertErrorEq(t, err, nil)
+		assertEq[any](t, v, int64(2))
+	})
+
+	t.Run("range", func(t *testing.T) {
+		o := opt.EpochTimeOption{Unit: time.Hour}
+		if err := o.Scan(int64(-24 * 365 * 1000)); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.V.Year() < 1000, true)
+
+		v, err := o.Value()
+		assertErrorEq(t, err, nil)
+		assertEq[any](t, v, int64(-24*365*1000))
+
+		o = opt.EpochTimeOption{Unit: time.Hour}
+		assertErrorEq(t, o.Scan(int64(math.MaxInt64)), errors.New("opt: epoch 9223372036854775807 in units of 1h0m0s overflows time.Time"))
+		assertEq(t, o.IsNull(), true)
+
+		o = opt.EpochTimeOption{Option: opt.From(time.Unix(math.MaxInt64/2, 0)), Unit: time.Nanosecond}
+		_, err = o.Value()
+		assertEq(t, err != nil, true)
+	})
+
+	t.Run("time source", func(t *testing.T) {
+		now := time.Now()
+		var o opt.EpochTimeOption
+		if err := o.Scan(now); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Option, opt.From(now))
+	})
+
+	t.Run("NULL", func(t *testing.T) {
+		o := opt.EpochTimeOption{Option: opt.From(time.Now())}
+		if err := o.Scan(nil); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Option, opt.New[time.Time]())
+
+		v, err := o.Value()
+		assertErrorEq(t, err, nil)
+		assertEq(t, v, nil)
+	})
+}
+
+func TestLocaleOption(t *testing.T) {
+	t.Run("configured", func(t *testing.T) {
+		o := opt.LocaleOption[float64]{ThousandsSep: '.', DecimalSep: ','}
+		if err := o.Scan("1.234,56"); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Option, opt.From(1234.56))
+
+		if err := o.Scan([]byte("-0,5")); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Option, opt.From(-0.5))
+
+		if err := o.Scan(2.5); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Option, opt.From(2.5))
+
+		if err := o.Scan(nil); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Option, opt.New[float64]())
+	})
+
+	t.Run("default", func(t *testing.T) {
+		var o opt.LocaleOption[float64]
+		if err := o.Scan("1234.56"); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Option, opt.From(1234.56))
+
+		assertErrorEq(t, o.Scan("1.234,56"), errors.New(`converting driver.Value type string ("1.234,56") to a float64: invalid syntax`))
+	})
+}
+
+func TestTrimOption(t *testing.T) {
+	var o opt.TrimOption[string]
+	if err := o.Scan("  hi  "); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o.Option, opt.From("hi"))
+
+	if err := o.Scan([]byte("\tab \n")); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o.Option, opt.From("ab"))
+
+	if err := o.Scan(nil); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o.Option, opt.New[string]())
+
+	var b opt.TrimOption[[]byte]
+	if err := b.Scan(" x "); err != nil {
+		t.Error(err)
+	}
+	assertBytesEq(t, b.V, []byte(" x "))
+}
+
+func TestBoolAsNumber(t *testing.T) {
+	var i opt.BoolAsNumber[int]
+	if err := i.Scan(true); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, i.Option, opt.From(1))
+
+	if err := i.Scan(false); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, i.Option, opt.From(0))
+
+	if err := i.Scan(int64(5)); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, i.Option, opt.From(5))
+
+	var f opt.BoolAsNumber[float64]
+	if err := f.Scan(true); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, f.Option, opt.From(1.0))
+
+	var b opt.BoolAsNumber[bool]
+	if err := b.Scan(true); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, b.Option, opt.From(true))
+
+	var strict opt.Option[int]
+	assertEq(t, strict.Scan(true) != nil, true)
+}
+
+func TestRuneOption(t *testing.T) {
+	cases := map[string]rune{"A": 'A', "é": 'é', "世": '世', "5": '5'}
+	for src, r := range cases {
+		var o opt.RuneOption
+		if err := o.Scan(src); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Option, opt.From(r))
+	}
+
+	var o opt.RuneOption
+	if err := o.Scan([]byte("Z")); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o.Option, opt.From('Z'))
+
+	if err := o.Scan(int64(66)); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o.Option, opt.From('B'))
+
+	assertErrorEq(t, o.Scan("AB"), errors.New(`converting driver.Value type string ("AB") to a rune: not a single character`))
+	assertErrorEq(t, o.Scan(""), errors.New(`converting driver.Value type string ("") to a rune: not a single character`))
+	assertErrorEq(t, o.Scan([]byte{0xff}), errors.New(`converting driver.Value type []uint8 ("\xff") to a rune: not a single character`))
+	assertEq(t, o.IsNull(), true)
+
+	if err := o.Scan(nil); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o.IsNull(), true)
+}
+
+// testDecimal is a decimal-like type that can only be constructed from a string
+type testDecimal struct {
+	units int64
+	scale int
+}
+
+func newTestDecimal(s string) (testDecimal, error) {
+	whole, frac, _ := strings.Cut(s, ".")
+	units, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return testDecimal{}, errors.New("invalid decimal " + s)
+	}
+
+	return testDecimal{units: units, scale: len(frac)}, nil
+}
+
+func TestRegisterParser(t *testing.T) {
+	t.Run("registered", testRegisterParser)
+
+	var o opt.Option[testDecimal]
+	assertEq(t, o.Scan("12.345") != nil, true)
+}
+
+func testRegisterParser(t *testing.T) {
+	opt.RegisterParser(newTestDecimal)
+	t.Cleanup(opt.UnregisterParser[testDecimal])
+
+	var o opt.Option[testDecimal]
+	if err := o.Scan("12.345"); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o, opt.From(testDecimal{units: 12345, scale: 3}))
+
+	if err := o.Scan([]byte("7")); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o, opt.From(testDecimal{units: 7}))
+
+	assertErrorEq(t, o.Scan("abc"), errors.New("invalid decimal abc"))
+
+	if err := o.Scan(nil); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o, opt.New[testDecimal]())
+
+	p, err := opt.Parse[testDecimal]("1.5")
+	assertErrorEq(t, err, nil)
+	assertEq(t, p, opt.From(testDecimal{units: 15, scale: 1}))
+}
+
+func TestDefaultOption(t *testing.T) {
+	o := opt.DefaultOption[string]{Default: "unknown"}
+	if err := o.Scan(nil); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o.Option, opt.From("unknown"))
+
+	if err := o.Scan("hello"); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o.Option, opt.From("hello"))
+
+	if err := o.Scan([]byte{}); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, o.Option, opt.From(""))
+}
+
+func TestPGArrayOption(t *testing.T) {
+	t.Run("Scan", func(t *testing.T) {
+		var o opt.PGArrayOption[int]
+		if err := o.Scan("{1,2,3}"); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Valid, true)
+		assertEq(t, len(o.V), 3)
+		assertEq(t, o.V[0], 1)
+		assertEq(t, o.V[2], 3)
+
+		if err := o.Scan([]byte("{}")); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Valid, true)
+		assertEq(t, o.V != nil, true)
+		assertEq(t, len(o.V), 0)
+
+		if err := o.Scan(nil); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.IsNull(), true)
+	})
+
+	t.Run("Scan strings", func(t *testing.T) {
+		var o opt.PGArrayOption[string]
+		if err := o.Scan(`{a, "b,c" ,"d\"e",""}`); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, len(o.V), 4)
+		assertEq(t, o.V[0], "a")
+		assertEq(t, o.V[1], "b,c")
+		assertEq(t, o.V[2], `d"e`)
+		assertEq(t, o.V[3], "")
+	})
+
+	t.Run("Scan errors", func(t *testing.T) {
+		var o opt.PGArrayOption[int]
+		assertErrorEq(t, o.Scan("1,2"), errors.New(`invalid Postgres array "1,2"`))
+		assertErrorEq(t, o.Scan("{1,NULL}"), errors.New("converting NULL element of Postgres array is unsupported"))
+		assertErrorEq(t, o.Scan("{{1},{2}}"), errors.New(`invalid Postgres array "{{1},{2}}": nested arrays are unsupported`))
+		assertErrorEq(t, o.Scan("{1,}"), errors.New(`invalid Postgres array "{1,}": trailing comma`))
+		assertErrorEq(t, o.Scan("{,}"), errors.New(`invalid Postgres array "{,}": empty element`))
+		assertErrorEq(t, o.Scan("{1,,2}"), errors.New(`invalid Postgres array "{1,,2}": empty element`))
+		assertErrorEq(t, o.Scan(`{"1}`), errors.New(`invalid Postgres array "{\"1}": unterminated quote`))
+		assertErrorEq(t, o.Scan("{a}"), errors.New(`converting driver.Value type string ("a") to a int: invalid syntax`))
+		assertErrorEq(t, o.Scan(int64(1)), errors.New("unsupported Scan, storing driver.Value type int64 into a Postgres array"))
+		assertEq(t, o.IsNull(), true)
+	})
+}
+
+func TestHStoreOption(t *testing.T) {
+	t.Run("Scan", func(t *testing.T) {
+		var o opt.HStoreOption
+		if err := o.Scan(`"a"=>"1", "b"=>"x\"y", c => "", "d"=>"e,f"`); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Valid, true)
+		assertEq(t, len(o.V), 4)
+		assertEq(t, o.V["a"], "1")
+		assertEq(t, o.V["b"], `x"y`)
+		assertEq(t, o.V["c"], "")
+		assertEq(t, o.V["d"], "e,f")
+
+		if err := o.Scan([]byte("")); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Valid, true)
+		assertEq(t, o.V != nil, true)
+		assertEq(t, len(o.V), 0)
+
+		if err := o.Scan(nil); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.IsNull(), true)
+	})
+
+	t.Run("Scan errors", func(t *testing.T) {
+		var o opt.HStoreOption
+		assertErrorEq(t, o.Scan(`"a"=>NULL`), errors.New("converting NULL value of hstore is unsupported"))
+		assertErrorEq(t, o.Scan(`"a"="1"`), errors.New(`invalid hstore "\"a\"=\"1\"": expected =>`))
+		assertErrorEq(t, o.Scan(`"a"=>"1`), errors.New(`invalid hstore "\"a\"=>\"1": unterminated quote`))
+		assertErrorEq(t, o.Scan(`"a"=>"1",`), errors.New(`invalid hstore "\"a\"=>\"1\",": trailing ,`))
+		assertErrorEq(t, o.Scan(int64(1)), errors.New("unsupported Scan, storing driver.Value type int64 into an hstore"))
+		assertEq(t, o.IsNull(), true)
+	})
+
+	t.Run("Value", func(t *testing.T) {
+		v, err := opt.HStoreOption{opt.From(map[string]string{"b": `x"y`, "a": "1"})}.Value()
+		assertErrorEq(t, err, nil)
+		assertEq[any](t, v, `"a"=>"1", "b"=>"x\"y"`)
+
+		v, err = opt.HStoreOption{}.Value()
+		assertErrorEq(t, err, nil)
+		assertEq(t, v, nil)
+	})
+}
+
+// testSetStringDecimal is a decimal-like type that is set from a string in a given base
+type testSetStringDecimal struct {
+	s string
+}
+
+func (d *testSetStringDecimal) SetString(s string, base int) error {
+	if base != 10 || strings.ContainsAny(s, "abc") {
+		return errors.New("invalid decimal " + s)
+	}
+
+	d.s = s
+	return nil
+}
+
+func TestScanSetString(t *testing.T) {
+	t.Run("mock", func(t *testing.T) {
+		var o opt.Option[testSetStringDecimal]
+		if err := o.Scan([]byte("123.450")); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o, opt.From(testSetStringDecimal{s: "123.450"}))
+
+		if err := o.Scan(int64(-7)); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o, opt.From(testSetStringDecimal{s: "-7"}))
+
+		assertErrorEq(t, o.Scan("abc"), errors.New("invalid decimal abc"))
+
+		if err := o.Scan(nil); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o, opt.New[testSetStringDecimal]())
+	})
+
+	t.Run("big.Int", func(t *testing.T) {
+		var o opt.Option[*big.Int]
+		if err := o.Scan("123456789012345678901234567890"); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.V.String(), "123456789012345678901234567890")
+
+		assertErrorEq(t, o.Scan("1.5"), errors.New(`converting driver.Value type string ("1.5") to a big.Int: invalid syntax`))
+	})
+
+	t.Run("big.Rat", func(t *testing.T) {
+		var o opt.Option[big.Rat]
+		if err := o.Scan([]byte("0.25")); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.V.String(), "1/4")
+	})
+}
+
+// testID is an ID type that is parsed from text of the form "id-<number>"
+type testID struct {
+	n int
+}
+
+func (id *testID) UnmarshalText(text []byte) error {
+	n, err := strconv.Atoi(strings.TrimPrefix(string(text), "id-"))
+	if err != nil || !strings.HasPrefix(string(text), "id-") {
+		return errors.New("invalid id " + string(text))
+	}
+
+	id.n = n
+	return nil
+}
+
+func TestScanTextUnmarshaler(t *testing.T) {
+	var id opt.Option[testID]
+	if err := id.Scan("id-12"); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, id, opt.From(testID{n: 12}))
+
+	if err := id.Scan([]byte("id-3")); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, id, opt.From(testID{n: 3}))
+
+	assertErrorEq(t, id.Scan("12"), errors.New("invalid id 12"))
+
+	if err := id.Scan(nil); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, id, opt.New[testID]())
+
+	var p opt.Option[*testID]
+	if err := p.Scan("id-4"); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, *p.V, testID{n: 4})
+
+	var addr opt.Option[netip.Addr]
+	if err := addr.Scan("192.168.0.1"); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, addr, opt.From(netip.MustParseAddr("192.168.0.1")))
+
+	var ip opt.Option[net.IP]
+	src := []byte{10, 0, 0, 1}
+	if err := ip.Scan(src); err != nil {
+		t.Error(err)
+	}
+	src[0] = 0
+	assertEq(t, ip.V.Equal(net.IPv4(10, 0, 0, 1)), true)
+
+	var ts opt.Option[time.Time]
+	if err := ts.Scan([]byte("2020-01-02T03:04:05Z")); err != nil {
+		t.Error(err)
+	}
+	assertEq(t, ts.V.Equal(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)), true)
+}
+
+func TestScanInterface(t *testing.T) {
+	t.Run("any", func(t *testing.T) {
+		src := []byte("abc")
+
+		var o opt.Option[any]
+		if err := o.Scan(src); err != nil {
+			t.Error(err)
+		}
+		src[0] = 'x'
+		assertBytesEq(t, o.V.([]byte), []byte("abc"))
+
+		if err := o.Scan(int64(1)); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o, opt.From[any](int64(1)))
+	})
+
+	t.Run("implemented", func(t *testing.T) {
+		ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		var o opt.Option[fmt.Stringer]
+		if err := o.Scan(ts); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o.Valid, true)
+		assertEq(t, o.V.String(), ts.String())
+
+		if err := o.Scan(nil); err != nil {
+			t.Error(err)
+		}
+		assertEq(t, o, opt.New[fmt.Stringer]())
+	})
+
+	t.Run("not implemented", func(t *testing.T) {
+		var o opt.Option[fmt.Stringer]
+		assertErrorEq(t, o.Scan(int64(1)), errors.New("unsupported Scan, storing driver.Value type int64 into type fmt.Stringer"))
+	})
+}
@@ -0,0 +1,20 @@
+package opt
+
+import "time"
+
+// FromTime creates an Option[time.Time] that is null if t is the zero time,
+// or non-null with t otherwise.
+func FromTime(t time.Time) Option[time.Time] {
+	if t.IsZero() {
+		return New[time.Time]()
+	}
+
+	return From(t)
+}
+
+// Before returns true if o is non-null and its time is before t.
+// Go does not allow methods on a specific instantiation such as Option[time.Time],
+// so this is a function rather than a method.
+func Before(o Option[time.Time], t time.Time) bool {
+	return o.Valid && o.V.Before(t)
+}
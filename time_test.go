@@ -0,0 +1,24 @@
+package opt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/FallenTaters/opt"
+)
+
+func TestFromTime(t *testing.T) {
+	now := time.Now()
+
+	assertEq(t, opt.FromTime(time.Time{}), opt.New[time.Time]())
+	assertEq(t, opt.FromTime(now), opt.From(now))
+}
+
+func TestBefore(t *testing.T) {
+	now := time.Now()
+
+	assertEq(t, opt.Before(opt.From(now), now.Add(time.Second)), true)
+	assertEq(t, opt.Before(opt.From(now), now), false)
+	assertEq(t, opt.Before(opt.From(now), now.Add(-time.Second)), false)
+	assertEq(t, opt.Before(opt.New[time.Time](), now), false)
+}